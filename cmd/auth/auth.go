@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// AuthCmd is the root command for inspecting the credentials in use.
+var AuthCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Inspect the credentials of the active profile",
+	Long: `Inspect the credentials of the active profile.
+
+Use 'virak-cli login' and 'virak-cli logout' to change them.`,
+}
+
+func init() {
+
+}
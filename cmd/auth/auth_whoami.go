@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+	httpc "github.com/virak-cloud/cli/pkg/http"
+)
+
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show the profile, user, token abilities and default zone in use",
+	Args:  cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return cli.Preflight(false)(cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token := cli.TokenFromContext(cmd.Context())
+
+		abilities, err := cli.TokenAbilities(cmd.Context(), token)
+		if err != nil {
+			slog.Error("failed to fetch token abilities", "error", err)
+			return fmt.Errorf("failed to fetch token abilities: %w", err)
+		}
+
+		info := presenter.Whoami{
+			Profile:     cli.ActiveProfile(),
+			TokenSource: cli.TokenSource(),
+			APIURL:      cli.APIURL(),
+			ZoneID:      cli.ProfileString("default.zoneId"),
+			ZoneName:    cli.ProfileString("default.zoneName"),
+			Abilities:   abilities,
+		}
+		if zoneID := viper.GetString("zoneId"); zoneID != "" {
+			info.ZoneID, info.ZoneName = zoneID, "(from VIRAK_ZONE_ID)"
+		}
+
+		// The user's details need user:read, which scoped tokens may lack
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		user, err := httpClient.GetUserProfile(cmd.Context())
+		switch {
+		case err == nil:
+			info.UserName, info.UserEmail = user.Data.Name, user.Data.Email
+		case httpc.IsForbidden(err):
+			info.UserName = "(token lacks user:read)"
+		default:
+			slog.Error("failed to get user profile", "error", err)
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+
+		return presenter.RenderWhoami(info)
+	},
+}
+
+func init() {
+	AuthCmd.AddCommand(whoamiCmd)
+}
@@ -3,17 +3,21 @@ package bucket
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	httpc "github.com/virak-cloud/cli/pkg/http"
 )
 
 type createOptions struct {
-	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	Name   string `flag:"name" usage:"Name of the bucket"`
-	Policy string `flag:"policy" default:"Private" usage:"Policy (Private|Public)"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	Name        string        `flag:"name" usage:"Name of the bucket"`
+	Policy      string        `flag:"policy" default:"Private" usage:"Policy (Private|Public)"`
+	Wait        bool          `flag:"wait" usage:"Wait until the bucket is Active"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var createOpt createOptions
@@ -29,7 +33,6 @@ var bucketCreateCmd = &cobra.Command{
 		// Declarative validation rules for the rest
 		return cli.Validate(cmd,
 			cli.Required("name"),
-			cli.OneOf("policy", "Private", "Public"),
 			// When not using --default-zone, zoneId is required (Preflight already ensures it)
 		)
 	},
@@ -42,20 +45,41 @@ var bucketCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		if _, err := httpClient.CreateObjectStorageBucket(zoneID, createOpt.Name, createOpt.Policy); err != nil {
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		// The create request does not return the new ID, so the wait tells the
+		// new bucket from older ones with the same name by the IDs listed now
+		var poll httpc.PollFunc
+		if createOpt.Wait {
+			var err error
+			if poll, err = httpClient.PollNewBucket(cmd.Context(), zoneID, createOpt.Name); err != nil {
+				return fmt.Errorf("failed to list buckets: %w", err)
+			}
+		}
+
+		resp, err := httpClient.CreateObjectStorageBucket(cmd.Context(), zoneID, createOpt.Name, createOpt.Policy)
+		if err != nil {
 			slog.Error("failed to create object storage bucket", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("object storage bucket creation request accepted", "zoneID", zoneID, "name", createOpt.Name, "policy", createOpt.Policy)
-		fmt.Println("Object storage bucket creation request accepted. Operation is asynchronous; check the bucket list for status.")
+		if err := presenter.Result(resp, "Object storage bucket creation request accepted. Operation is asynchronous; check the bucket list for status."); err != nil {
+			return err
+		}
+		if createOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("bucket %q", createOpt.Name), poll, httpc.WaitOptions{
+				Target:  []string{httpc.BucketStatusActive},
+				Timeout: createOpt.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(bucketCreateCmd)
+	cli.RequireAbilities(bucketCreateCmd, "bucket:write")
 
 	_ = cli.BindFlagsFromStruct(bucketCreateCmd, &createOpt)
+	cli.Enum(bucketCreateCmd, "policy", "Private", "Public")
 }
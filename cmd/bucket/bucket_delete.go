@@ -3,7 +3,9 @@ package bucket
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/pkg/http"
 
@@ -11,8 +13,10 @@ import (
 )
 
 type deleteOptions struct {
-	ZoneID   string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	BucketID string `flag:"bucketId" usage:"Id of the bucket"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	BucketID    string        `flag:"bucketId" usage:"Id of the bucket"`
+	Wait        bool          `flag:"wait" usage:"Wait until the bucket is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var deleteOpt deleteOptions
@@ -39,8 +43,12 @@ var objectStorageDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		err := httpClient.DeleteObjectStorageBucket(zoneID, deleteOpt.BucketID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Bucket.Target(deleteOpt.BucketID)); !ok {
+			return err
+		}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		err := httpClient.DeleteObjectStorageBucket(cmd.Context(), zoneID, deleteOpt.BucketID)
 		if err != nil {
 			slog.Error("failed to delete object storage bucket", "error", err, "zoneID", zoneID, "bucketId", deleteOpt.BucketID)
 			return fmt.Errorf("error: %w", err)
@@ -48,12 +56,21 @@ var objectStorageDeleteCmd = &cobra.Command{
 		}
 
 		slog.Info("object storage bucket deleted successfully", "zoneId", zoneID, "bucketId", deleteOpt.BucketID)
-		fmt.Println("Object storage bucket deleted successfully.")
+		if err := presenter.Result(nil, "Object storage bucket deleted successfully."); err != nil {
+			return err
+		}
+		if deleteOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("bucket %s", deleteOpt.BucketID), httpClient.PollBucket(zoneID, deleteOpt.BucketID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   deleteOpt.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(objectStorageDeleteCmd)
+	cli.RequireAbilities(objectStorageDeleteCmd, "bucket:write")
 	_ = cli.BindFlagsFromStruct(objectStorageDeleteCmd, &deleteOpt)
 }
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
 	"github.com/spf13/cobra"
 )
@@ -14,6 +15,10 @@ import (
 type eventsOptions struct {
 	ZoneID   string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	BucketID string `flag:"bucketId" usage:"Id of the bucket"`
+	Page     int    `flag:"page" default:"1" usage:"Page number to fetch"`
+	PerPage  int    `flag:"per-page" usage:"Number of events per page (server default when 0)"`
+	All      bool   `flag:"all" usage:"Fetch every page starting at --page"`
+	presenter.ListOptions
 }
 
 var eventOpt eventsOptions
@@ -36,34 +41,45 @@ var objectStorageEventsCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &eventOpt); err != nil {
 			return err
 		}
+		if eventOpt.ListColumns {
+			return presenter.ListColumns(presenter.BucketEventColumns)
+		}
+		list, err := presenter.NewList(eventOpt.ListOptions, presenter.BucketEventColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-
-		if eventOpt.BucketID != "" {
-			eventsResponse, err := httpClient.GetObjectStorageBucketEvents(zoneID, eventOpt.BucketID)
-			if err != nil {
-				slog.Error("failed to get object storage bucket events", "error", err, "zoneID", zoneID, "bucketId", eventOpt.BucketID)
-				return fmt.Errorf("error: %w", err)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		page := http.PageOptions{Page: eventOpt.Page, PerPage: eventOpt.PerPage}
 
+		var eventsResponse *responses.ObjectStorageEventsResponse
+		switch {
+		case eventOpt.All:
+			var events []responses.ObjectStorageEvent
+			if events, err = http.CollectPages(httpClient.AllObjectStorageEvents(cmd.Context(), zoneID, eventOpt.BucketID, page)); err == nil {
+				eventsResponse = &responses.ObjectStorageEventsResponse{Data: events, Meta: presenter.AllPagesMeta(len(events))}
 			}
-			slog.Info("successfully retrieved object storage bucket events", "zoneID", zoneID, "count", len(eventsResponse.Data))
-			presenter.RenderBucketEvents(eventsResponse.Data)
-		} else {
-			eventsResponse, err := httpClient.GetObjectStorageEvents(zoneID)
-			if err != nil {
-				slog.Error("failed to get object storage events", "error", err, "zoneID", zoneID)
-				fmt.Println("Error:", err)
-				return fmt.Errorf("error: %w", err)
-			}
-			slog.Info("successfully retrieved object storage events", "zoneID", zoneID, "count", len(eventsResponse.Data))
-			presenter.RenderBucketEvents(eventsResponse.Data)
+		case eventOpt.BucketID != "":
+			eventsResponse, err = httpClient.GetObjectStorageBucketEvents(cmd.Context(), zoneID, eventOpt.BucketID, page)
+		default:
+			eventsResponse, err = httpClient.GetObjectStorageEvents(cmd.Context(), zoneID, page)
+		}
+		if err != nil {
+			slog.Error("failed to get object storage events", "error", err, "zoneID", zoneID, "bucketId", eventOpt.BucketID)
+			return fmt.Errorf("error: %w", err)
+		}
+		slog.Info("successfully retrieved object storage events", "zoneID", zoneID, "count", len(eventsResponse.Data))
+		if err := list.Output(eventsResponse, eventsResponse.Data); err != nil {
+			return err
 		}
+		presenter.RenderPageInfo(eventsResponse.Meta)
 		return nil
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(objectStorageEventsCmd)
+	cli.RequireAbilities(objectStorageEventsCmd, "bucket:read")
 
 	_ = cli.BindFlagsFromStruct(objectStorageEventsCmd, &eventOpt)
 
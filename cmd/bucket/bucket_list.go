@@ -14,6 +14,7 @@ import (
 // ListOptions contains options for listing object storage buckets.
 type ListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var listOpts ListOptions
@@ -29,11 +30,22 @@ var objectStorageListCmd = &cobra.Command{
 	},
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &listOpts); err != nil {
+			return err
+		}
+		if listOpts.ListColumns {
+			return presenter.ListColumns(presenter.BucketColumns)
+		}
+		list, err := presenter.NewList(listOpts.ListOptions, presenter.BucketColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		bucketsResponse, err := httpClient.GetObjectStorageBuckets(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		bucketsResponse, err := httpClient.GetObjectStorageBuckets(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get object storage buckets", "error", err, "zoneID", zoneID)
 			return fmt.Errorf("Error: %w", err)
@@ -41,14 +53,13 @@ var objectStorageListCmd = &cobra.Command{
 		}
 
 		slog.Info("successfully retrieved object storage buckets", "zoneID", zoneID, "count", len(bucketsResponse.Data))
-		presenter.RenderBucketList(bucketsResponse.Data)
-
-		return nil
+		return list.Output(bucketsResponse, bucketsResponse.Data)
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(objectStorageListCmd)
+	cli.RequireAbilities(objectStorageListCmd, "bucket:read")
 	_ = cli.BindFlagsFromStruct(objectStorageListCmd, &listOpts)
 
 }
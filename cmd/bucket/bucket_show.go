@@ -38,8 +38,8 @@ var objectStorageShowCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		bucketResponse, err := httpClient.GetObjectStorageBucket(zoneID, showOpt.BucketID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		bucketResponse, err := httpClient.GetObjectStorageBucket(cmd.Context(), zoneID, showOpt.BucketID)
 		if err != nil {
 			slog.Error("failed to get object storage bucket", "error", err, "zoneID", zoneID, "bucketId", showOpt.BucketID)
 			return fmt.Errorf("error: %w", err)
@@ -47,13 +47,13 @@ var objectStorageShowCmd = &cobra.Command{
 		}
 
 		slog.Info("successfully retrieved object storage bucket", "zoneID", zoneID, "bucketId", showOpt.BucketID)
-		presenter.RenderBucketDetail(bucketResponse.Data)
-		return nil
+		return presenter.RenderBucketDetail(bucketResponse)
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(objectStorageShowCmd)
+	cli.RequireAbilities(objectStorageShowCmd, "bucket:read")
 	_ = cli.BindFlagsFromStruct(objectStorageShowCmd, &showOpt)
 
 }
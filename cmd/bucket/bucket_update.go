@@ -3,6 +3,7 @@ package bucket
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"log/slog"
 
 	"github.com/virak-cloud/cli/pkg/http"
@@ -27,7 +28,6 @@ var objectStorageUpdateCmd = &cobra.Command{
 		}
 
 		return cli.Validate(cmd,
-			cli.OneOf("policy", "Private", "Public"),
 			cli.IsUlid("bucketId"),
 			cli.Required("bucketId"),
 		)
@@ -41,8 +41,8 @@ var objectStorageUpdateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.UpdateObjectStorageBucket(zoneID, updateOpt.BucketID, updateOpt.Policy)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.UpdateObjectStorageBucket(cmd.Context(), zoneID, updateOpt.BucketID, updateOpt.Policy)
 		if err != nil {
 			slog.Error("failed to update object storage bucket", "error", err, "zoneID", zoneID, "bucketId", updateOpt.BucketID, "policy", updateOpt.Policy)
 			return fmt.Errorf("error: %w", err)
@@ -50,12 +50,13 @@ var objectStorageUpdateCmd = &cobra.Command{
 		}
 
 		slog.Info("bucket update request accepted", "zoneID", zoneID, "bucketId", updateOpt.BucketID, "policy", updateOpt.Policy)
-		fmt.Println("Bucket update request accepted. Operation is asynchronous; check the bucket list or show endpoint for status.")
-		return nil
+		return presenter.Result(resp, "Bucket update request accepted. Operation is asynchronous; check the bucket list or show endpoint for status.")
 	},
 }
 
 func init() {
 	ObjectStorageCmd.AddCommand(objectStorageUpdateCmd)
+	cli.RequireAbilities(objectStorageUpdateCmd, "bucket:write")
 	_ = cli.BindFlagsFromStruct(objectStorageUpdateCmd, &updateOpt)
+	cli.Enum(objectStorageUpdateCmd, "policy", "Private", "Public")
 }
@@ -3,28 +3,32 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type createOptions struct {
-	ZoneID                  string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	Name                    string `flag:"name" usage:"Cluster name"`
-	VersionID               string `flag:"versionId" usage:"Kubernetes version ID"`
-	OfferingID              string `flag:"offeringId" usage:"Service offering ID"`
-	SSHKeyID                string `flag:"sshKeyId" usage:"SSH key ID"`
-	NetworkID               string `flag:"networkId" usage:"Network ID"`
-	HAEnabled               bool   `flag:"ha" usage:"Enable high availability"`
-	ClusterSize             int    `flag:"size" usage:"Cluster size" default:"1"`
-	Description             string `flag:"description" usage:"Cluster description"`
-	PrivateRegistryUsername string `flag:"privateRegistryUsername" usage:"Private registry username"`
-	PrivateRegistryPassword string `flag:"privateRegistryPassword" usage:"Private registry password"`
-	PrivateRegistryURL      string `flag:"privateRegistryUrl" usage:"Private registry URL"`
-	HAConfigControllerNodes int    `flag:"haControllerNodes" usage:"HA config controller nodes"`
-	HAConfigExternalLBIP    string `flag:"haExternalLBIP" usage:"HA config external load balancer IP"`
+	ZoneID                  string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	Name                    string        `flag:"name" usage:"Cluster name"`
+	VersionID               string        `flag:"versionId" usage:"Kubernetes version ID"`
+	OfferingID              string        `flag:"offeringId" usage:"Service offering ID"`
+	SSHKeyID                string        `flag:"sshKeyId" usage:"SSH key ID"`
+	NetworkID               string        `flag:"networkId" usage:"Network ID"`
+	HAEnabled               bool          `flag:"ha" usage:"Enable high availability"`
+	ClusterSize             int           `flag:"size" usage:"Cluster size" default:"1"`
+	Description             string        `flag:"description" usage:"Cluster description"`
+	PrivateRegistryUsername string        `flag:"privateRegistryUsername" usage:"Private registry username"`
+	PrivateRegistryPassword string        `flag:"privateRegistryPassword" usage:"Private registry password"`
+	PrivateRegistryURL      string        `flag:"privateRegistryUrl" usage:"Private registry URL"`
+	HAConfigControllerNodes int           `flag:"haControllerNodes" usage:"HA config controller nodes"`
+	HAConfigExternalLBIP    string        `flag:"haExternalLBIP" usage:"HA config external load balancer IP"`
+	Wait                    bool          `flag:"wait" usage:"Wait until the cluster is Running"`
+	WaitTimeout             time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var createOpts createOptions
@@ -56,15 +60,33 @@ var kubernetesClusterCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.CreateKubernetesCluster(zoneID, createOpts.Name, createOpts.VersionID, createOpts.OfferingID, createOpts.SSHKeyID, createOpts.NetworkID, createOpts.HAEnabled, createOpts.ClusterSize, createOpts.Description, createOpts.PrivateRegistryUsername, createOpts.PrivateRegistryPassword, createOpts.PrivateRegistryURL, createOpts.HAConfigControllerNodes, createOpts.HAConfigExternalLBIP)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		// The create request does not return the new ID, so the wait tells the
+		// new cluster from older ones with the same name by the IDs listed now
+		var poll http.PollFunc
+		if createOpts.Wait {
+			var err error
+			if poll, err = httpClient.PollNewKubernetesCluster(cmd.Context(), zoneID, createOpts.Name); err != nil {
+				return fmt.Errorf("failed to list clusters: %w", err)
+			}
+		}
+
+		resp, err := httpClient.CreateKubernetesCluster(cmd.Context(), zoneID, createOpts.Name, createOpts.VersionID, createOpts.OfferingID, createOpts.SSHKeyID, createOpts.NetworkID, createOpts.HAEnabled, createOpts.ClusterSize, createOpts.Description, createOpts.PrivateRegistryUsername, createOpts.PrivateRegistryPassword, createOpts.PrivateRegistryURL, createOpts.HAConfigControllerNodes, createOpts.HAConfigExternalLBIP)
 		if err != nil {
 			slog.Error("failed to create kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster created successfully")
-		fmt.Println("kubernetes cluster created successfully. Check status with 'virak-cli cluster list'")
+		if err := presenter.Result(resp, "kubernetes cluster created successfully. Check status with 'virak-cli cluster list'"); err != nil {
+			return err
+		}
+		if createOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %q", createOpts.Name), poll, http.WaitOptions{
+				Target:  []string{http.ClusterStatusRunning},
+				Timeout: createOpts.WaitTimeout,
+			})
+		}
 
 		return nil
 	},
@@ -72,5 +94,6 @@ var kubernetesClusterCreateCmd = &cobra.Command{
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterCreateCmd)
+	cli.RequireAbilities(kubernetesClusterCreateCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterCreateCmd, &createOpts)
 }
@@ -3,16 +3,20 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
-	ZoneID    string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
-	ClusterID string `flag:"clusterId" usage:"Cluster ID"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	ClusterID   string        `flag:"clusterId" usage:"Cluster ID"`
+	Wait        bool          `flag:"wait" usage:"Wait until the cluster is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var deleteOpts deleteOptions
@@ -37,15 +41,27 @@ var kubernetesClusterDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.DeleteKubernetesCluster(zoneID, deleteOpts.ClusterID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Cluster.Target(deleteOpts.ClusterID)); !ok {
+			return err
+		}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteKubernetesCluster(cmd.Context(), zoneID, deleteOpts.ClusterID)
 		if err != nil {
 			slog.Error("failed to delete kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster deleted successfully")
-		fmt.Println("Kubernetes cluster deleted successfully")
+		if err := presenter.Result(resp, "Kubernetes cluster deleted successfully"); err != nil {
+			return err
+		}
+		if deleteOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %s", deleteOpts.ClusterID), httpClient.PollKubernetesCluster(zoneID, deleteOpts.ClusterID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   deleteOpts.WaitTimeout,
+			})
+		}
 
 		return nil
 	},
@@ -53,5 +69,6 @@ var kubernetesClusterDeleteCmd = &cobra.Command{
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterDeleteCmd)
+	cli.RequireAbilities(kubernetesClusterDeleteCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterDeleteCmd, &deleteOpts)
 }
@@ -3,21 +3,30 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type listOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var listOpts listOptions
 
+var clusterColumns = []presenter.Column[responses.KubernetesCluster]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "status", Header: "Status"},
+	{Path: "kubernetes_version.version", Header: "Version"},
+	{Path: "cluster_size", Header: "Worker Size"},
+}
+
 var kubernetesClusterListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all kubernetes clusters",
@@ -28,29 +37,33 @@ var kubernetesClusterListCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &listOpts); err != nil {
+			return err
+		}
+		if listOpts.ListColumns {
+			return presenter.ListColumns(clusterColumns)
+		}
+		list, err := presenter.NewList(listOpts.ListOptions, clusterColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		clusters, err := httpClient.GetKubernetesClusters(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		clusters, err := httpClient.GetKubernetesClusters(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get kubernetes clusters", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Name", "Status", "Version", "Worker Size"})
-
-		for _, cluster := range clusters.Data {
-			table.Append([]string{cluster.ID, cluster.Name, cluster.Status, cluster.KubernetesVersion.Version, fmt.Sprintf("%d", cluster.ClusterSize)})
-		}
-		table.Render()
-
-		return nil
+		return list.Output(clusters, clusters.Data)
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterListCmd)
+	cli.RequireAbilities(kubernetesClusterListCmd, "kubernetes:read")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterListCmd, &listOpts)
 }
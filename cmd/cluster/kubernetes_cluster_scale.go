@@ -5,18 +5,22 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type scaleOptions struct {
-	ZoneID         string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	ClusterID      string `flag:"clusterId" usage:"Cluster ID"`
-	AutoScaling    bool   `flag:"auto-scaling" usage:"Enable auto scaling"`
-	ClusterSize    int    `flag:"cluster-size" usage:"Cluster size (required if auto-scaling is false)"`
-	MinClusterSize int    `flag:"min-cluster-size" usage:"Minimum cluster size (required if auto-scaling is true)"`
-	MaxClusterSize int    `flag:"max-cluster-size" usage:"Maximum cluster size (required if auto-scaling is true)"`
+	ZoneID         string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	ClusterID      string        `flag:"clusterId" usage:"Cluster ID"`
+	AutoScaling    bool          `flag:"auto-scaling" usage:"Enable auto scaling"`
+	ClusterSize    int           `flag:"cluster-size" usage:"Cluster size (required if auto-scaling is false)"`
+	MinClusterSize int           `flag:"min-cluster-size" usage:"Minimum cluster size (required if auto-scaling is true)"`
+	MaxClusterSize int           `flag:"max-cluster-size" usage:"Maximum cluster size (required if auto-scaling is true)"`
+	Wait           bool          `flag:"wait" usage:"Wait until the cluster is Running again"`
+	WaitTimeout    time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var scaleOpts scaleOptions
@@ -61,21 +65,30 @@ var kubernetesClusterScaleCmd = &cobra.Command{
 			}
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.ScaleKubernetesCluster(zoneID, scaleOpts.ClusterID, scaleOpts.AutoScaling, scaleOpts.ClusterSize, scaleOpts.MinClusterSize, scaleOpts.MaxClusterSize)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ScaleKubernetesCluster(cmd.Context(), zoneID, scaleOpts.ClusterID, scaleOpts.AutoScaling, scaleOpts.ClusterSize, scaleOpts.MinClusterSize, scaleOpts.MaxClusterSize)
 		if err != nil {
 			slog.Error("failed to scale kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster scaled successfully")
-		fmt.Println("kubernetes cluster scaled successfully")
-
+		if err := presenter.Result(resp, "kubernetes cluster scaled successfully"); err != nil {
+			return err
+		}
+		if scaleOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %s", scaleOpts.ClusterID), httpClient.PollKubernetesCluster(zoneID, scaleOpts.ClusterID), http.WaitOptions{
+				Target:  []string{http.ClusterStatusRunning},
+				From:    []string{http.ClusterStatusRunning},
+				Timeout: scaleOpts.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterScaleCmd)
+	cli.RequireAbilities(kubernetesClusterScaleCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterScaleCmd, &scaleOpts)
 }
@@ -3,12 +3,11 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -40,20 +39,21 @@ var kubernetesClusterShowCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		cluster, err := httpClient.GetKubernetesCluster(zoneID, showOpts.ClusterID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		cluster, err := httpClient.GetKubernetesCluster(cmd.Context(), zoneID, showOpts.ClusterID)
 		if err != nil {
 			slog.Error("failed to get kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Name", "Status", "Version", "Size", "Created At"})
-		table.Append([]string{cluster.Data.ID, cluster.Data.Name, cluster.Data.Status, cluster.Data.KubernetesVersion.Version, fmt.Sprintf("%d", cluster.Data.ClusterSize), fmt.Sprintf("%d", cluster.Data.CreatedAt)})
-		table.Render()
+		table := presenter.NewTable("ID", "Name", "Status", "Version", "Size", "Created At")
+		table.Append(cluster.Data.ID, cluster.Data.Name, cluster.Data.Status, cluster.Data.KubernetesVersion.Version, fmt.Sprintf("%d", cluster.Data.ClusterSize), fmt.Sprintf("%d", cluster.Data.CreatedAt))
+		if err := presenter.Output(cluster, table); err != nil {
+			return err
+		}
 
 		if cluster.Data.Status == "Failed" && cluster.Data.FailedReason != "" {
-			fmt.Printf("\nCluster failed: %s\n", cluster.Data.FailedReason)
+			presenter.Status("\nCluster failed: %s", cluster.Data.FailedReason)
 		}
 
 		return nil
@@ -62,5 +62,6 @@ var kubernetesClusterShowCmd = &cobra.Command{
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterShowCmd)
+	cli.RequireAbilities(kubernetesClusterShowCmd, "kubernetes:read")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterShowCmd, &showOpts)
 }
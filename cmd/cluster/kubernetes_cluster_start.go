@@ -3,16 +3,20 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type startOptions struct {
-	ZoneID    string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
-	ClusterID string `flag:"clusterId" usage:"Cluster ID"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	ClusterID   string        `flag:"clusterId" usage:"Cluster ID"`
+	Wait        bool          `flag:"wait" usage:"Wait until the cluster is Running"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var startOpts startOptions
@@ -37,15 +41,23 @@ var kubernetesClusterStartCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.StartKubernetesCluster(zoneID, startOpts.ClusterID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.StartKubernetesCluster(cmd.Context(), zoneID, startOpts.ClusterID)
 		if err != nil {
 			slog.Error("failed to start kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster started successfully")
-		fmt.Println("Success")
+		if err := presenter.Result(resp, "Success"); err != nil {
+			return err
+		}
+		if startOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %s", startOpts.ClusterID), httpClient.PollKubernetesCluster(zoneID, startOpts.ClusterID), http.WaitOptions{
+				Target:  []string{http.ClusterStatusRunning},
+				Timeout: startOpts.WaitTimeout,
+			})
+		}
 
 		return nil
 	},
@@ -53,5 +65,6 @@ var kubernetesClusterStartCmd = &cobra.Command{
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterStartCmd)
+	cli.RequireAbilities(kubernetesClusterStartCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterStartCmd, &startOpts)
 }
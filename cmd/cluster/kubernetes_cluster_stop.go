@@ -3,16 +3,20 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type stopOptions struct {
-	ZoneID    string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
-	ClusterID string `flag:"clusterId" usage:"Cluster ID"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	ClusterID   string        `flag:"clusterId" usage:"Cluster ID"`
+	Wait        bool          `flag:"wait" usage:"Wait until the cluster is Stopped"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var stopOpts stopOptions
@@ -37,15 +41,23 @@ var kubernetesClusterStopCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.StopKubernetesCluster(zoneID, stopOpts.ClusterID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.StopKubernetesCluster(cmd.Context(), zoneID, stopOpts.ClusterID)
 		if err != nil {
 			slog.Error("failed to stop kubernetes cluster", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster stopped successfully")
-		fmt.Println("Success")
+		if err := presenter.Result(resp, "Success"); err != nil {
+			return err
+		}
+		if stopOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %s", stopOpts.ClusterID), httpClient.PollKubernetesCluster(zoneID, stopOpts.ClusterID), http.WaitOptions{
+				Target:  []string{http.ClusterStatusStopped},
+				Timeout: stopOpts.WaitTimeout,
+			})
+		}
 
 		return nil
 	},
@@ -53,5 +65,6 @@ var kubernetesClusterStopCmd = &cobra.Command{
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterStopCmd)
+	cli.RequireAbilities(kubernetesClusterStopCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterStopCmd, &stopOpts)
 }
@@ -5,16 +5,20 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type updateOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
-	ClusterID   string `flag:"clusterId" usage:"Cluster ID"`
-	Name        string `flag:"name" usage:"New cluster name"`
-	Description string `flag:"description" usage:"New cluster description"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	ClusterID   string        `flag:"clusterId" usage:"Cluster ID"`
+	Name        string        `flag:"name" usage:"New cluster name"`
+	Description string        `flag:"description" usage:"New cluster description"`
+	Wait        bool          `flag:"wait" usage:"Wait until the cluster is Running"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var updateOpts updateOptions
@@ -44,22 +48,29 @@ var kubernetesClusterUpdateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		cluster, err := httpClient.UpdateKubernetesClusterDetails(zoneID, updateOpts.ClusterID, updateOpts.Name, updateOpts.Description)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		cluster, err := httpClient.UpdateKubernetesClusterDetails(cmd.Context(), zoneID, updateOpts.ClusterID, updateOpts.Name, updateOpts.Description)
 		if err != nil {
 			slog.Error("failed to update kubernetes cluster", "error", err)
-			fmt.Println("Error: failed to update kubernetes cluster.")
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("kubernetes cluster updated successfully")
-		fmt.Printf("Kubernetes cluster updated successfully!\nID: %s\nName: %s\nDescription: %s\n", cluster.Data.ID, cluster.Data.Name, cluster.Data.Description)
-
+		if err := presenter.Result(cluster, "Kubernetes cluster updated successfully!\nID: %s\nName: %s\nDescription: %s", cluster.Data.ID, cluster.Data.Name, cluster.Data.Description); err != nil {
+			return err
+		}
+		if updateOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("cluster %s", updateOpts.ClusterID), httpClient.PollKubernetesCluster(zoneID, updateOpts.ClusterID), http.WaitOptions{
+				Target:  []string{http.ClusterStatusRunning},
+				Timeout: updateOpts.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesClusterUpdateCmd)
+	cli.RequireAbilities(kubernetesClusterUpdateCmd, "kubernetes:write")
 	_ = cli.BindFlagsFromStruct(kubernetesClusterUpdateCmd, &updateOpts)
 }
@@ -3,21 +3,28 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type serviceEventsOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var serviceEventsOpts serviceEventsOptions
 
+var serviceEventColumns = []presenter.Column[responses.KubernetesEvent]{
+	{Path: "id", Header: "ID"},
+	{Path: "message", Header: "Message"},
+	{Path: "timestamp", Header: "Timestamp"},
+}
+
 var kubernetesServiceEventsCmd = &cobra.Command{
 	Use:   "service-events",
 	Short: "List kubernetes service events",
@@ -28,29 +35,33 @@ var kubernetesServiceEventsCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &serviceEventsOpts); err != nil {
+			return err
+		}
+		if serviceEventsOpts.ListColumns {
+			return presenter.ListColumns(serviceEventColumns)
+		}
+		list, err := presenter.NewList(serviceEventsOpts.ListOptions, serviceEventColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		events, err := httpClient.GetKubernetesServiceEvents(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		events, err := httpClient.GetKubernetesServiceEvents(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get kubernetes service events", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Message", "Timestamp"})
-
-		for _, e := range events.Data {
-			table.Append([]string{e.ID, e.Message, fmt.Sprintf("%d", e.Timestamp)})
-		}
-		table.Render()
-
-		return nil
+		return list.Output(events, events.Data)
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesServiceEventsCmd)
+	cli.RequireAbilities(kubernetesServiceEventsCmd, "kubernetes:read")
 	_ = cli.BindFlagsFromStruct(kubernetesServiceEventsCmd, &serviceEventsOpts)
 }
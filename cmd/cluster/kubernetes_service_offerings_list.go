@@ -3,21 +3,35 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type serviceOfferingsListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var serviceOfferingsListOpts serviceOfferingsListOptions
 
+var serviceOfferingColumns = []presenter.Column[responses.KubernetesServiceOffering]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "is_available", Header: "Available"},
+	{Path: "hardware.cpu_core", Header: "CPU Cores"},
+	{Path: "hardware.cpu_speed_MHz", Header: "CPU MHz"},
+	{Path: "hardware.memory_mb", Header: "RAM MB"},
+	{Path: "hardware.root_disk_size_gB", Header: "Disk GB"},
+	{Path: "hardware.network_rate", Header: "Network Rate"},
+	{Path: "hourly_price.up", Header: "Price Up (per hour)"},
+	{Path: "hourly_price.down", Header: "Price Down (per hour)"},
+}
+
 var kubernetesServiceOfferingsListCmd = &cobra.Command{
 	Use:     "offering",
 	Aliases: []string{"service-offerings", "offerings-list", "offerings", "service-offerings-list"},
@@ -29,43 +43,33 @@ var kubernetesServiceOfferingsListCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &serviceOfferingsListOpts); err != nil {
+			return err
+		}
+		if serviceOfferingsListOpts.ListColumns {
+			return presenter.ListColumns(serviceOfferingColumns)
+		}
+		list, err := presenter.NewList(serviceOfferingsListOpts.ListOptions, serviceOfferingColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		offerings, err := httpClient.GetKubernetesServiceOfferings(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		offerings, err := httpClient.GetKubernetesServiceOfferings(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get kubernetes service offerings", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{
-			"ID", "Name", "Available", "CPU Cores", "CPU MHz", "RAM MB", "Disk GB",
-			"Network Rate", "Price Up (per hour)", "Price Down (per hour)",
-		})
-
-		for _, o := range offerings.Data {
-			table.Append([]string{
-				o.ID,
-				o.Name,
-				fmt.Sprintf("%t", o.IsAvailable),
-				fmt.Sprintf("%d", o.Hardware.CPUCore),
-				fmt.Sprintf("%d", o.Hardware.CPUSpeedMHz),
-				fmt.Sprintf("%d", o.Hardware.MemoryMB),
-				fmt.Sprintf("%d", o.Hardware.RootDiskSizeGB),
-				fmt.Sprintf("%d", o.Hardware.NetworkRate),
-				fmt.Sprintf("%d", o.HourlyPrice.Up),
-				fmt.Sprintf("%d", o.HourlyPrice.Down),
-			})
-		}
-		table.Render()
-
-		return nil
+		return list.Output(offerings, offerings.Data)
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesServiceOfferingsListCmd)
+	cli.RequireAbilities(kubernetesServiceOfferingsListCmd, "kubernetes:read")
 	_ = cli.BindFlagsFromStruct(kubernetesServiceOfferingsListCmd, &serviceOfferingsListOpts)
 }
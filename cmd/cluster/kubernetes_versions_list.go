@@ -3,21 +3,28 @@ package cluster
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type versionsListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var versionsListOpts versionsListOptions
 
+var versionColumns = []presenter.Column[responses.KubernetesVersion]{
+	{Path: "id", Header: "ID"},
+	{Path: "version", Header: "Version"},
+	{Path: "enabled", Header: "Enabled"},
+}
+
 var kubernetesVersionsListCmd = &cobra.Command{
 	Use:   "versions-list",
 	Short: "List available kubernetes versions",
@@ -28,29 +35,33 @@ var kubernetesVersionsListCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &versionsListOpts); err != nil {
+			return err
+		}
+		if versionsListOpts.ListColumns {
+			return presenter.ListColumns(versionColumns)
+		}
+		list, err := presenter.NewList(versionsListOpts.ListOptions, versionColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		versions, err := httpClient.GetKubernetesVersions(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		versions, err := httpClient.GetKubernetesVersions(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get kubernetes versions", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Version", "Enabled"})
-
-		for _, v := range versions.Data {
-			table.Append([]string{v.ID, v.Version, fmt.Sprintf("%t", v.Enabled)})
-		}
-		table.Render()
-
-		return nil
+		return list.Output(versions, versions.Data)
 	},
 }
 
 func init() {
 	KubernetesClusterCmd.AddCommand(kubernetesVersionsListCmd)
+	cli.RequireAbilities(kubernetesVersionsListCmd, "kubernetes:read")
 	_ = cli.BindFlagsFromStruct(kubernetesVersionsListCmd, &versionsListOpts)
 }
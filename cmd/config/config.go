@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// ConfigCmd represents the config command
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and edit the CLI configuration",
+	Long: `Inspect and edit ~/.virak-cli.yaml without editing YAML by hand.
+
+Keys that belong to a profile (auth.token, default.zoneId, default.zoneName,
+apiUrl and rateLimit.*) are read from and written to the active profile; select
+another one with --profile. Run 'virak-cli config list' to see every key.`,
+}
+
+func init() {
+
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+)
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the config file in your editor and validate it afterwards",
+	Long: `Open the config file in $VISUAL or $EDITOR (vi, or notepad on Windows, when
+neither is set). The file is validated when the editor exits.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := cli.ConfigFile()
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := cli.EditConfig(func(map[string]any) error { return nil }); err != nil {
+				return err
+			}
+		}
+
+		editor := strings.Fields(editorCommand())
+		editCmd := exec.CommandContext(cmd.Context(), editor[0], append(editor[1:], path)...)
+		editCmd.Stdin, editCmd.Stdout, editCmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := editCmd.Run(); err != nil {
+			return fmt.Errorf("failed to run editor %q: %w", editor[0], err)
+		}
+		return validateConfigFile()
+	},
+}
+
+func editorCommand() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+func init() {
+	ConfigCmd.AddCommand(configEditCmd)
+}
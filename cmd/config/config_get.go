@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+)
+
+var configGetCmd = &cobra.Command{
+	Use:     "get <key>",
+	Short:   "Print the value of a config key",
+	Example: `  virak-cli config get default.zoneId`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, err := cli.LookupConfigKey(args[0])
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		value, ok, err := key.Get()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%s is not set", key.Name)
+		}
+		fmt.Println(value)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configGetCmd)
+}
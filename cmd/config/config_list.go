@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var configListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List config keys and their values for the active profile",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries := make([]presenter.ConfigEntry, 0, len(cli.ConfigKeys))
+		for _, key := range cli.ConfigKeys {
+			entry := presenter.ConfigEntry{Key: key.Name, Description: key.Description, Secret: key.Secret}
+			if key.Credential {
+				// Avoid decrypting the credential store just to mask the token
+				if cli.HasToken(cli.ActiveProfile()) {
+					entry.Value, entry.Secret = "(stored)", false
+				}
+				entries = append(entries, entry)
+				continue
+			}
+			value, ok, err := key.Get()
+			if err != nil {
+				return err
+			}
+			if ok {
+				entry.Value = fmt.Sprint(value)
+			}
+			entries = append(entries, entry)
+		}
+		presenter.Status("Profile: %s", cli.ActiveProfile())
+		return presenter.RenderConfigList(entries)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configListCmd)
+}
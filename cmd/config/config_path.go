@@ -0,0 +1,22 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+)
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the config file",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println(cli.ConfigFile())
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configPathCmd)
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config key",
+	Example: `  virak-cli config set default.zoneId 01J9Y6ZQ5HZ0NE0000000000T1
+  virak-cli config set retries 5
+  virak-cli --profile staging config set rateLimit.requestsPerSecond 2`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, err := cli.LookupConfigKey(args[0])
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		if key.Profile {
+			if err := cli.ValidateProfileName(cli.ActiveProfile()); err != nil {
+				return err
+			}
+		}
+		value, err := key.Parse(args[1])
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		if key.Change != nil {
+			if err := key.Change(cmd.Context(), value); err != nil {
+				return err
+			}
+		}
+		if err := key.Set(value); err != nil {
+			slog.Error("failed to set config key", "key", key.Name, "error", err)
+			return err
+		}
+		slog.Info("config key set", "key", key.Name, "path", key.Path())
+		presenter.Status("%s updated.", key.Name)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configSetCmd)
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var configUnsetCmd = &cobra.Command{
+	Use:     "unset <key>",
+	Short:   "Remove a config key",
+	Example: `  virak-cli config unset rateLimit.burst`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, err := cli.LookupConfigKey(args[0])
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		if key.Change != nil {
+			if err := key.Change(cmd.Context(), nil); err != nil {
+				return err
+			}
+		}
+		if err := key.Unset(); err != nil {
+			slog.Error("failed to unset config key", "key", key.Name, "error", err)
+			return err
+		}
+		slog.Info("config key unset", "key", key.Name, "path", key.Path())
+		presenter.Status("%s removed.", key.Name)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configUnsetCmd)
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the config file for unknown keys and invalid values",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return validateConfigFile()
+	},
+}
+
+// validateConfigFile reports every problem of the config file on stderr.
+func validateConfigFile() error {
+	settings, err := cli.ReadConfigFile()
+	if err != nil {
+		return err
+	}
+	problems := cli.ValidateConfig(settings)
+	if len(problems) == 0 {
+		presenter.Status("%s is valid.", cli.ConfigFile())
+		return nil
+	}
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+	return fmt.Errorf("%s has %d problem(s)", cli.ConfigFile(), len(problems))
+}
+
+func init() {
+	ConfigCmd.AddCommand(configValidateCmd)
+}
@@ -0,0 +1,11 @@
+package dev
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DevCmd groups tooling for developing against the CLI without a real account.
+var DevCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Developer tooling such as an offline fake API server",
+}
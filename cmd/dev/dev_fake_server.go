@@ -0,0 +1,114 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	nethttp "net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+type fakeServerOptions struct {
+	Addr           string        `flag:"addr" default:"127.0.0.1:8787" usage:"Address to listen on"`
+	AcceptToken    string        `flag:"accept-token" usage:"Only accept this bearer token (any non-empty token is accepted by default)"`
+	ProvisionDelay time.Duration `flag:"provision-delay" default:"0s" usage:"How long asynchronous operations take to complete, e.g. 10s to exercise --wait"`
+	Abilities      []string      `flag:"abilities" usage:"Abilities of the token, e.g. instance:read,zone:read (all by default)"`
+	Quiet          bool          `flag:"quiet" usage:"Do not log requests"`
+}
+
+var fakeServerOpt fakeServerOptions
+
+var fakeServerCmd = &cobra.Command{
+	Use:   "fake-server",
+	Short: "Run an in-memory fake of the Virak Cloud API on localhost",
+	Long: `Run an in-memory fake of the Virak Cloud API for offline testing and demos.
+
+The server is seeded with two zones, service offerings, VM images, network
+offerings, Kubernetes versions and a user account. Instances, networks,
+firewall rules, DNS domains and records, buckets and Kubernetes clusters can be
+created, changed and deleted; state is lost when the server stops.`,
+	Example: `  virak-cli dev fake-server
+  virak-cli dev fake-server --addr 127.0.0.1:9000 --provision-delay 15s`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &fakeServerOpt); err != nil {
+			return err
+		}
+
+		opts := []fake.Option{
+			fake.WithToken(fakeServerOpt.AcceptToken),
+			fake.WithProvisionDelay(fakeServerOpt.ProvisionDelay),
+		}
+		if len(fakeServerOpt.Abilities) > 0 {
+			opts = append(opts, fake.WithAbilities(fakeServerOpt.Abilities))
+		}
+		var handler nethttp.Handler = fake.NewServer(opts...)
+		if !fakeServerOpt.Quiet {
+			handler = logRequests(handler)
+		}
+
+		listener, err := net.Listen("tcp", fakeServerOpt.Addr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", fakeServerOpt.Addr, err)
+		}
+		server := &nethttp.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+		fmt.Printf("Fake Virak Cloud API listening on http://%s\n", listener.Addr())
+		fmt.Printf("Default zone ID: %s\n", fake.DefaultZoneID)
+		fmt.Println("Press Ctrl+C to stop.")
+		slog.Info("fake API server started", "addr", listener.Addr().String())
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- server.Serve(listener) }()
+
+		select {
+		case err := <-errCh:
+			if !errors.Is(err, nethttp.ErrServerClosed) {
+				return fmt.Errorf("fake API server failed: %w", err)
+			}
+		case <-cmd.Context().Done():
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(ctx); err != nil {
+				return fmt.Errorf("failed to stop fake API server: %w", err)
+			}
+		}
+		slog.Info("fake API server stopped")
+		return nil
+	},
+}
+
+// statusRecorder captures the status code written by a handler.
+type statusRecorder struct {
+	nethttp.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests prints one line per request to stderr.
+func logRequests(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: nethttp.StatusOK}
+		next.ServeHTTP(rec, r)
+		fmt.Fprintf(os.Stderr, "%s %s -> %d (%s)\n", r.Method, r.URL.RequestURI(), rec.status, time.Since(start).Round(time.Microsecond))
+	})
+}
+
+func init() {
+	DevCmd.AddCommand(fakeServerCmd)
+
+	_ = cli.BindFlagsFromStruct(fakeServerCmd, &fakeServerOpt)
+}
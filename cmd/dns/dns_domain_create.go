@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -34,20 +35,20 @@ var domainCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.CreateDomain(createOpts.Domain)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.CreateDomain(cmd.Context(), createOpts.Domain)
 		if err != nil {
 			slog.Error("failed to create domain", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("domain created successfully")
-		fmt.Println("Domain creation initiated successfully, consider to setup nameservers if not done already.")
-		return nil
+		return presenter.Result(resp, "Domain creation initiated successfully, consider to setup nameservers if not done already.")
 	},
 }
 
 func init() {
 	domainCmd.AddCommand(domainCreateCmd)
+	cli.RequireAbilities(domainCreateCmd, "dns:write")
 	_ = cli.BindFlagsFromStruct(domainCreateCmd, &createOpts)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -33,20 +34,24 @@ var domainDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.DeleteDomain(deleteOpts.Domain)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "DNS domain", Name: deleteOpts.Domain}); !ok {
+			return err
+		}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteDomain(cmd.Context(), deleteOpts.Domain)
 		if err != nil {
 			slog.Error("failed to delete domain", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("domain deleted successfully")
-		fmt.Println("Domain Delete request submitted successfully.")
-		return nil
+		return presenter.Result(resp, "Domain Delete request submitted successfully.")
 	},
 }
 
 func init() {
 	domainCmd.AddCommand(domainDeleteCmd)
+	cli.RequireAbilities(domainDeleteCmd, "dns:write")
 	_ = cli.BindFlagsFromStruct(domainDeleteCmd, &deleteOpts)
 }
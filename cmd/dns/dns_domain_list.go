@@ -3,16 +3,26 @@ package dns
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
+type domainListOptions struct {
+	presenter.ListOptions
+}
+
+var domainListOpts domainListOptions
+
+var domainColumns = []presenter.Column[responses.Domain]{
+	{Path: "domain", Header: "Domain"},
+	{Path: "status", Header: "Status"},
+}
+
 var domainListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all domains",
@@ -21,28 +31,31 @@ var domainListCmd = &cobra.Command{
 		return cli.Preflight(false)(cmd, args)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &domainListOpts); err != nil {
+			return err
+		}
+		if domainListOpts.ListColumns {
+			return presenter.ListColumns(domainColumns)
+		}
+		list, err := presenter.NewList(domainListOpts.ListOptions, domainColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetDomains()
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetDomains(cmd.Context())
 		if err != nil {
 			slog.Error("failed to get domains", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		renderDomainList(resp)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderDomainList(resp *responses.DomainList) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Domain", "Status"})
-	for _, domain := range resp.Data {
-		table.Append([]string{domain.Domain, domain.Status})
-	}
-	table.Render()
-}
-
 func init() {
 	domainCmd.AddCommand(domainListCmd)
+	cli.RequireAbilities(domainListCmd, "dns:read")
+	_ = cli.BindFlagsFromStruct(domainListCmd, &domainListOpts)
 }
@@ -3,12 +3,11 @@ package dns
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
@@ -36,29 +35,28 @@ var domainShowCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetDomain(showOpts.Domain)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetDomain(cmd.Context(), showOpts.Domain)
 		if err != nil {
 			slog.Error("failed to get domain", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		renderDomainShow(resp)
-		return nil
+		return renderDomainShow(resp)
 	},
 }
 
-func renderDomainShow(resp *responses.DomainShow) {
-	if resp.Data.Domain == "" || resp.Data.Status == "" {
-		fmt.Println("Domain is in pending, please check later")
-		return
+func renderDomainShow(resp *responses.DomainShow) error {
+	if (resp.Data.Domain == "" || resp.Data.Status == "") && presenter.Tabular() {
+		presenter.Status("Domain is in pending, please check later")
+		return nil
 	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Domain", "Status"})
-	table.Append([]string{resp.Data.Domain, resp.Data.Status})
-	table.Render()
+	table := presenter.NewTable("Domain", "Status")
+	table.Append(resp.Data.Domain, resp.Data.Status)
+	return presenter.Output(resp, table)
 }
 
 func init() {
 	domainCmd.AddCommand(domainShowCmd)
+	cli.RequireAbilities(domainShowCmd, "dns:read")
 	_ = cli.BindFlagsFromStruct(domainShowCmd, &showOpts)
 }
@@ -3,16 +3,23 @@ package dns
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
-	"os"
-
-	"github.com/olekukonko/tablewriter"
 
 	"github.com/spf13/cobra"
 )
 
+type eventsOptions struct {
+	Page    int  `flag:"page" default:"1" usage:"Page number to fetch"`
+	PerPage int  `flag:"per-page" usage:"Number of events per page (server default when 0)"`
+	All     bool `flag:"all" usage:"Fetch every page starting at --page"`
+	presenter.ListOptions
+}
+
+var eventsOpt eventsOptions
+
 var dnsEventsCmd = &cobra.Command{
 	Use:   "events",
 	Short: "Get DNS events",
@@ -23,31 +30,55 @@ var dnsEventsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		token := cli.TokenFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		res, err := httpClient.GetDNSEvents()
+		if err := cli.LoadFromCobraFlags(cmd, &eventsOpt); err != nil {
+			return err
+		}
+		if eventsOpt.ListColumns {
+			return presenter.ListColumns(dnsEventColumns)
+		}
+		list, err := presenter.NewList(eventsOpt.ListOptions, dnsEventColumns)
 		if err != nil {
-			slog.Error("failed to get dns events", "error", err)
-			return fmt.Errorf("error: %w", err)
+			return cli.UsageError(err)
+		}
+		page := http.PageOptions{Page: eventsOpt.Page, PerPage: eventsOpt.PerPage}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		var res *responses.DNSEventsResponse
+		if eventsOpt.All {
+			events, err := http.CollectPages(httpClient.AllDNSEvents(cmd.Context(), page))
+			if err != nil {
+				slog.Error("failed to get dns events", "error", err)
+				return fmt.Errorf("error: %w", err)
+			}
+			res = &responses.DNSEventsResponse{Data: events, Meta: presenter.AllPagesMeta(len(events))}
+		} else {
+			var err error
+			res, err = httpClient.GetDNSEvents(cmd.Context(), page)
+			if err != nil {
+				slog.Error("failed to get dns events", "error", err)
+				return fmt.Errorf("error: %w", err)
+			}
 		}
-		if len(res.Data) == 0 {
+		if len(res.Data) == 0 && presenter.Tabular() {
 			slog.Info("No dns events found.")
 			return nil
 		}
-		renderDNSEvents(res)
+		if err := list.Output(res, res.Data); err != nil {
+			return err
+		}
+		presenter.RenderPageInfo(res.Meta)
 		return nil
 	},
 }
 
-func renderDNSEvents(eventsResponse *responses.DNSEventsResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Type", "Content", "Created At"})
-
-	for _, event := range eventsResponse.Data {
-		table.Append([]string{event.Type, event.Content, fmt.Sprintf("%d", event.CreatedAt)})
-	}
-	table.Render()
+var dnsEventColumns = []presenter.Column[responses.DNSEvent]{
+	{Path: "type", Header: "Type"},
+	{Path: "content", Header: "Content"},
+	{Path: "created_at", Header: "Created At"},
 }
 
 func init() {
 	DnsCmd.AddCommand(dnsEventsCmd)
+	cli.RequireAbilities(dnsEventsCmd, "dns:read")
+	_ = cli.BindFlagsFromStruct(dnsEventsCmd, &eventsOpt)
 }
@@ -2,6 +2,9 @@ package dns
 
 import "github.com/spf13/cobra"
 
+// recordTypes are the DNS record types the API accepts.
+var recordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "CAA", "TLSA"}
+
 var recordCmd = &cobra.Command{
 	Use:   "record",
 	Short: "Manage domain records",
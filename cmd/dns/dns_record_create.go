@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -49,7 +50,6 @@ var recordCreateCmd = &cobra.Command{
 			cli.RequiredIf("license", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
 			cli.RequiredIf("choicer", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
 			cli.RequiredIf("match", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
-			cli.OneOf("type", "A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "CAA", "TLSA"),
 		)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -59,20 +59,21 @@ var recordCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.CreateRecord(recordCreateOpts.Domain, recordCreateOpts.Record, recordCreateOpts.Type, recordCreateOpts.Content, recordCreateOpts.TTL, recordCreateOpts.Priority, recordCreateOpts.Weight, recordCreateOpts.Port, recordCreateOpts.Flags, recordCreateOpts.Tag, recordCreateOpts.License, recordCreateOpts.Choicer, recordCreateOpts.Match)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.CreateRecord(cmd.Context(), recordCreateOpts.Domain, recordCreateOpts.Record, recordCreateOpts.Type, recordCreateOpts.Content, recordCreateOpts.TTL, recordCreateOpts.Priority, recordCreateOpts.Weight, recordCreateOpts.Port, recordCreateOpts.Flags, recordCreateOpts.Tag, recordCreateOpts.License, recordCreateOpts.Choicer, recordCreateOpts.Match)
 		if err != nil {
 			slog.Error("failed to create record", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("record created successfully")
-		fmt.Println("Success")
-		return nil
+		return presenter.Result(resp, "Success")
 	},
 }
 
 func init() {
 	recordCmd.AddCommand(recordCreateCmd)
+	cli.RequireAbilities(recordCreateCmd, "dns:write")
 	_ = cli.BindFlagsFromStruct(recordCreateCmd, &recordCreateOpts)
+	cli.Enum(recordCreateCmd, "type", recordTypes...)
 }
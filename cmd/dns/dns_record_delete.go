@@ -8,6 +8,7 @@ import (
 
 	"github.com/virak-cloud/cli/internal"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -33,34 +34,39 @@ var recordDeleteCmd = &cobra.Command{
 			cli.Required("record"),
 			cli.Required("type"),
 			cli.Required("content-id"),
-			cli.OneOf("type", "A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "CAA", "TLSA"),
 		)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		token := cli.TokenFromContext(cmd.Context())
+
+		if err := cli.LoadFromCobraFlags(cmd, &recordDeleteOpts); err != nil {
+			return err
+		}
+
 		if !internal.IsValidULID(recordDeleteOpts.ContentID) {
 			return fmt.Errorf("error: content-id must be a valid ULID")
 		}
 
-		token := cli.TokenFromContext(cmd.Context())
-
-		if err := cli.LoadFromCobraFlags(cmd, &recordDeleteOpts); err != nil {
+		record := fmt.Sprintf("%s %s.%s", recordDeleteOpts.Type, recordDeleteOpts.Record, recordDeleteOpts.Domain)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "DNS record", ID: recordDeleteOpts.ContentID, Name: record}); !ok {
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.DeleteRecord(recordDeleteOpts.Domain, recordDeleteOpts.Record, recordDeleteOpts.Type, recordDeleteOpts.ContentID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteRecord(cmd.Context(), recordDeleteOpts.Domain, recordDeleteOpts.Record, recordDeleteOpts.Type, recordDeleteOpts.ContentID)
 		if err != nil {
 			slog.Error("failed to delete record", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("record deleted successfully")
-		fmt.Println("Success")
-		return nil
+		return presenter.Result(resp, "Success")
 	},
 }
 
 func init() {
 	recordCmd.AddCommand(recordDeleteCmd)
+	cli.RequireAbilities(recordDeleteCmd, "dns:write")
 	_ = cli.BindFlagsFromStruct(recordDeleteCmd, &recordDeleteOpts)
+	cli.Enum(recordDeleteCmd, "type", recordTypes...)
 }
@@ -3,19 +3,19 @@ package dns
 import (
 	"fmt"
 	"log/slog"
-	"os"
 	"strings"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type recordListOptions struct {
 	Domain string `flag:"domain" usage:"Domain name"`
+	presenter.ListOptions
 }
 
 var recordListOpts recordListOptions
@@ -36,37 +36,46 @@ var recordListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &recordListOpts); err != nil {
 			return err
 		}
+		if recordListOpts.ListColumns {
+			return presenter.ListColumns(recordColumns)
+		}
+		list, err := presenter.NewList(recordListOpts.ListOptions, recordColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetRecords(recordListOpts.Domain)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetRecords(cmd.Context(), recordListOpts.Domain)
 		if err != nil {
 			slog.Error("failed to get records", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		renderRecordList(resp)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderRecordList(resp *responses.RecordList) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Name", "Type", "TTL", "Status", "Protected", "Content"})
-	for _, record := range resp.Data {
+var recordColumns = []presenter.Column[responses.Record]{
+	{Path: "name", Header: "Name"},
+	{Path: "type", Header: "Type"},
+	{Path: "ttl", Header: "TTL"},
+	{Path: "status", Header: "Status"},
+	{Path: "is_protected", Header: "Protected", Value: func(r responses.Record) string {
+		if r.IsProtected {
+			return "Yes"
+		}
+		return "No"
+	}},
+	{Path: "content", Header: "Content", Value: func(r responses.Record) string {
 		var contents []string
-		for _, content := range record.Content {
+		for _, content := range r.Content {
 			contents = append(contents, content.ContentRaw)
 		}
-		contentStr := strings.Join(contents, ", ")
-		protectedStr := "No"
-		if record.IsProtected {
-			protectedStr = "Yes"
-		}
-		table.Append([]string{record.Name, record.Type, fmt.Sprintf("%d", record.TTL), record.Status, protectedStr, contentStr})
-	}
-	table.Render()
+		return strings.Join(contents, ", ")
+	}},
 }
 
 func init() {
 	recordCmd.AddCommand(recordListCmd)
+	cli.RequireAbilities(recordListCmd, "dns:read")
 	_ = cli.BindFlagsFromStruct(recordListCmd, &recordListOpts)
 }
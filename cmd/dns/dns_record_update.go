@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -53,7 +54,6 @@ var recordUpdateCmd = &cobra.Command{
 			cli.RequiredIf("license", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
 			cli.RequiredIf("choicer", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
 			cli.RequiredIf("match", func(v cli.Values) bool { return v.GetString("type") == "TLSA" }),
-			cli.OneOf("type", "A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "CAA", "TLSA"),
 		)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -64,20 +64,21 @@ var recordUpdateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		_, err := httpClient.UpdateRecord(recordUpdateOpts.Domain, recordUpdateOpts.Record, recordUpdateOpts.Type, recordUpdateOpts.ContentID, recordUpdateOpts.Content, recordUpdateOpts.TTL, recordUpdateOpts.Priority, recordUpdateOpts.Weight, recordUpdateOpts.Port, recordUpdateOpts.Flags, recordUpdateOpts.Tag, recordUpdateOpts.License, recordUpdateOpts.Choicer, recordUpdateOpts.Match)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.UpdateRecord(cmd.Context(), recordUpdateOpts.Domain, recordUpdateOpts.Record, recordUpdateOpts.Type, recordUpdateOpts.ContentID, recordUpdateOpts.Content, recordUpdateOpts.TTL, recordUpdateOpts.Priority, recordUpdateOpts.Weight, recordUpdateOpts.Port, recordUpdateOpts.Flags, recordUpdateOpts.Tag, recordUpdateOpts.License, recordUpdateOpts.Choicer, recordUpdateOpts.Match)
 		if err != nil {
 			slog.Error("failed to update record", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("record updated successfully")
-		fmt.Println("Record updated successfully")
-		return nil
+		return presenter.Result(resp, "Record updated successfully")
 	},
 }
 
 func init() {
 	recordCmd.AddCommand(recordUpdateCmd)
+	cli.RequireAbilities(recordUpdateCmd, "dns:write")
 	_ = cli.BindFlagsFromStruct(recordUpdateCmd, &recordUpdateOpts)
+	cli.Enum(recordUpdateCmd, "type", recordTypes...)
 }
@@ -13,6 +13,7 @@ import (
 
 type documentsOptions struct {
 	Year int `flag:"year" usage:"Year to fetch cost documents for"`
+	presenter.ListOptions
 }
 
 var documentsOpt documentsOptions
@@ -33,23 +34,33 @@ var financeDocumentsCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &documentsOpt); err != nil {
 			return err
 		}
+		if documentsOpt.ListColumns {
+			return presenter.ListColumns(presenter.CostDocumentColumns)
+		}
+		list, err := presenter.NewList(documentsOpt.ListOptions, presenter.CostDocumentColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := httpc.NewClient(token)
-		resp, err := httpClient.ListDocumentsGET(documentsOpt.Year)
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListDocumentsGET(cmd.Context(), documentsOpt.Year)
 		if err != nil {
 			slog.Error("failed to get cost documents", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("cost documents retrieved successfully", "year", documentsOpt.Year)
-		fmt.Println("Cost documents retrieved successfully.")
-		presenter.RenderCostDocuments(resp.Data)
-		return nil
+		presenter.Status("Cost documents retrieved successfully.")
+		if presenter.Empty(len(resp.Data), "No cost documents found.") {
+			return nil
+		}
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	FinanceCmd.AddCommand(financeDocumentsCmd)
+	cli.RequireAbilities(financeDocumentsCmd, "finance:read")
 
 	_ = cli.BindFlagsFromStruct(financeDocumentsCmd, &documentsOpt)
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 	"github.com/virak-cloud/cli/internal/cli"
 	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
 	"github.com/spf13/cobra"
 )
@@ -30,6 +31,9 @@ type expensesOptions struct {
 	productType string
 	productID   string
 	page        uint
+	perPage     uint
+	all         bool
+	presenter.ListOptions
 }
 
 var expensesOpt expensesOptions
@@ -44,6 +48,17 @@ var financeExpensesCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		token := cli.TokenFromContext(cmd.Context())
 
+		if err := cli.LoadFromCobraFlags(cmd, &expensesOpt); err != nil {
+			return err
+		}
+		if expensesOpt.ListColumns {
+			return presenter.ListColumns(presenter.ExpenseColumns)
+		}
+		list, err := presenter.NewList(expensesOpt.ListOptions, presenter.ExpenseColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		// Validate required parameters
 		if expensesOpt.productType == "" {
 			return fmt.Errorf("product-type is required")
@@ -75,28 +90,46 @@ var financeExpensesCmd = &cobra.Command{
 		if expensesOpt.expenseType != "" {
 			filters["type"] = expensesOpt.expenseType
 		}
-		if expensesOpt.page > 0 {
-			filters["page"] = fmt.Sprintf("%d", expensesOpt.page)
+		page := http.PageOptions{Page: int(expensesOpt.page), PerPage: int(expensesOpt.perPage)}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		if expensesOpt.all {
+			expenses, err := http.CollectPages(httpClient.AllExpenses(cmd.Context(), expensesOpt.productType, expensesOpt.productID, filters, page))
+			if err != nil {
+				return fmt.Errorf("could not fetch expenses: %w", err)
+			}
+			if presenter.Empty(len(expenses), "No expenses found.") {
+				return nil
+			}
+			return list.Output(&responses.ExpensesListResponse{Data: expenses, Meta: presenter.AllPagesMeta(len(expenses))}, expenses)
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListExpensesWithRequiredParams(expensesOpt.productType, expensesOpt.productID, filters)
+		resp, err := httpClient.ListExpensesWithRequiredParams(cmd.Context(), expensesOpt.productType, expensesOpt.productID, filters, page)
 		if err != nil {
 			return fmt.Errorf("could not fetch expenses: %w", err)
 		}
 
-		presenter.RenderExpenses(resp.Data)
+		if presenter.Empty(len(resp.Data), "No expenses found.") {
+			return nil
+		}
+		if err := list.Output(resp, resp.Data); err != nil {
+			return err
+		}
+		presenter.RenderPageInfo(resp.Meta)
 		return nil
 	},
 }
 
 func init() {
 	FinanceCmd.AddCommand(financeExpensesCmd)
+	cli.RequireAbilities(financeExpensesCmd, "finance:read")
 	financeExpensesCmd.Flags().StringVar(&expensesOpt.startDate, "start-date", "", "Start date for filtering expenses (YYYY-MM-DD format)")
 	financeExpensesCmd.Flags().StringVar(&expensesOpt.endDate, "end-date", "", "End date for filtering expenses (YYYY-MM-DD format)")
 	financeExpensesCmd.Flags().StringVar(&expensesOpt.expenseType, "type", "", "Type of expenses to filter by")
 	financeExpensesCmd.Flags().StringVar(&expensesOpt.productType, "product-type", "", "Product type (required)")
 	financeExpensesCmd.Flags().StringVar(&expensesOpt.productID, "product-id", "", "Product ID (required)")
-	financeExpensesCmd.Flags().UintVar(&expensesOpt.page, "page", 1, "Page number for pagination (default 1)")
+	financeExpensesCmd.Flags().UintVar(&expensesOpt.page, "page", 1, "Page number for pagination")
+	financeExpensesCmd.Flags().UintVar(&expensesOpt.perPage, "per-page", 0, "Number of expenses per page (server default when 0)")
+	financeExpensesCmd.Flags().BoolVar(&expensesOpt.all, "all", false, "Fetch every page starting at --page")
 	_ = cli.BindFlagsFromStruct(financeExpensesCmd, &expensesOpt)
 }
@@ -11,7 +11,9 @@ import (
 	httpc "github.com/virak-cloud/cli/pkg/http"
 )
 
-type paymentsOptions struct{}
+type paymentsOptions struct {
+	presenter.ListOptions
+}
 
 var paymentsOpt paymentsOptions
 
@@ -34,22 +36,32 @@ var financePaymentsCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &paymentsOpt); err != nil {
 			return err
 		}
+		if paymentsOpt.ListColumns {
+			return presenter.ListColumns(presenter.PaymentColumns)
+		}
+		list, err := presenter.NewList(paymentsOpt.ListOptions, presenter.PaymentColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := httpc.NewClient(token)
-		resp, err := httpClient.ListPayments()
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListPayments(cmd.Context())
 		if err != nil {
 			slog.Error("failed to list payments", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("payment history retrieved successfully", "count", len(resp.Data))
-		fmt.Println("Payment history retrieved successfully.")
-		presenter.RenderPayments(resp.Data)
-		return nil
+		presenter.Status("Payment history retrieved successfully.")
+		if presenter.Empty(len(resp.Data), "No payments found.") {
+			return nil
+		}
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	FinanceCmd.AddCommand(financePaymentsCmd)
+	cli.RequireAbilities(financePaymentsCmd, "finance:read")
 	_ = cli.BindFlagsFromStruct(financePaymentsCmd, &paymentsOpt)
 }
\ No newline at end of file
@@ -32,22 +32,22 @@ var financeWalletCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		resp, err := httpClient.GetWallet()
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetWallet(cmd.Context())
 		if err != nil {
 			slog.Error("failed to get wallet", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("wallet information retrieved successfully")
-		fmt.Println("Wallet information retrieved successfully.")
-		presenter.RenderWallet(*resp)
-		return nil
+		presenter.Status("Wallet information retrieved successfully.")
+		return presenter.RenderWallet(resp)
 	},
 }
 
 func init() {
 	FinanceCmd.AddCommand(financeWalletCmd)
+	cli.RequireAbilities(financeWalletCmd, "finance:read")
 
 	_ = cli.BindFlagsFromStruct(financeWalletCmd, &walletOpt)
 }
\ No newline at end of file
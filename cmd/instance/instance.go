@@ -1,8 +1,6 @@
 package instance
 
 import (
-	"strings"
-
 	"github.com/spf13/cobra"
 )
 
@@ -13,18 +11,6 @@ var InstanceCmd = &cobra.Command{
 	Short:   "Manage instances in a zone",
 }
 
-// SplitAndTrim splits a string by comma and trims spaces, removing empty parts.
-func SplitAndTrim(s string) []string {
-	parts := []string{}
-	for _, p := range strings.Split(s, ",") {
-		trimmed := strings.TrimSpace(p)
-		if trimmed != "" {
-			parts = append(parts, trimmed)
-		}
-	}
-	return parts
-}
-
 func init() {
 
 }
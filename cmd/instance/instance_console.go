@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -36,19 +37,19 @@ var instanceConsoleCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 
-		resp, err := httpClient.GetInstanceConsole(zoneID, consoleOpt.InstanceID)
+		resp, err := httpClient.GetInstanceConsole(cmd.Context(), zoneID, consoleOpt.InstanceID)
 		if err != nil {
 			return fmt.Errorf("could not get instance console: %w", err)
 		}
 
-		fmt.Printf("Console URL: %s\n", resp.Data.URL)
-		return nil
+		return presenter.Result(resp, "Console URL: %s", resp.Data.URL)
 	},
 }
 
 func init() {
 	InstanceCmd.AddCommand(instanceConsoleCmd)
+	cli.RequireAbilities(instanceConsoleCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceConsoleCmd, &consoleOpt)
 }
\ No newline at end of file
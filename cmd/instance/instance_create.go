@@ -8,21 +8,25 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type instanceCreateOptions struct {
-	ZoneID            string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	ServiceOfferingID string `flag:"service-offering-id" usage:"ID of the service offering"`
-	VMImageID         string `flag:"vm-image-id" usage:"ID of the VM image"`
-	NetworkIDsRaw     string `flag:"network-ids" usage:"JSON array of network IDs, e.g. '[\"id1\",\"id2\"]'"`
-	Name              string `flag:"name" usage:"Name of the instance"`
-	Interactive       bool   `flag:"interactive" usage:"Run interactive instance creation workflow"`
+	ZoneID            string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	ServiceOfferingID string        `flag:"service-offering-id" usage:"ID of the service offering"`
+	VMImageID         string        `flag:"vm-image-id" usage:"ID of the VM image"`
+	NetworkIDsRaw     string        `flag:"network-ids" usage:"JSON array of network IDs, e.g. '[\"id1\",\"id2\"]'"`
+	Name              string        `flag:"name" usage:"Name of the instance"`
+	Interactive       bool          `flag:"interactive" usage:"Run interactive instance creation workflow"`
+	Wait              bool          `flag:"wait" usage:"Wait until the instance is UP"`
+	WaitTimeout       time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var createOpt instanceCreateOptions
@@ -56,13 +60,13 @@ var instanceCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 
 		if createOpt.Interactive {
 			reader := bufio.NewReader(os.Stdin)
 
 			// Service Offering Selection
-			soResp, err := httpClient.ListInstanceServiceOfferings(zoneID)
+			soResp, err := httpClient.ListInstanceServiceOfferings(cmd.Context(), zoneID)
 			if err != nil || soResp == nil || len(soResp.Data) == 0 {
 				slog.Error("failed to fetch service offerings", "error", err)
 				return fmt.Errorf("could not fetch service offerings")
@@ -100,7 +104,7 @@ var instanceCreateCmd = &cobra.Command{
 			createOpt.ServiceOfferingID = activeOfferings[soIdx].ID
 
 			// VM Image Selection
-			imgResp, err := httpClient.ListInstanceVMImages(zoneID)
+			imgResp, err := httpClient.ListInstanceVMImages(cmd.Context(), zoneID)
 			if err != nil {
 				slog.Error("failed to fetch VM images", "error", err)
 				return fmt.Errorf("could not fetch VM images")
@@ -129,7 +133,7 @@ var instanceCreateCmd = &cobra.Command{
 			createOpt.VMImageID = imgResp.Data[imgIdx].ID
 
 			// Network Selection
-			netResp, err := httpClient.ListNetworks(zoneID)
+			netResp, err := httpClient.ListNetworks(cmd.Context(), zoneID)
 			if err != nil || netResp == nil || len(netResp.Data) == 0 {
 				slog.Error("failed to fetch networks", "error", err)
 				return fmt.Errorf("could not fetch networks")
@@ -207,34 +211,33 @@ var instanceCreateCmd = &cobra.Command{
 			return fmt.Errorf("--network-ids must be a JSON array of strings, e.g. '[\"id1\",\"id2\"]'")
 		}
 
-		resp, err := httpClient.CreateInstance(zoneID, createOpt.ServiceOfferingID, createOpt.VMImageID, networkIds, createOpt.Name)
+		// The create request does not return the new ID, so the wait tells the
+		// new instance from older ones with the same name by the IDs listed now
+		var poll http.PollFunc
+		if createOpt.Wait {
+			var err error
+			if poll, err = httpClient.PollNewInstance(cmd.Context(), zoneID, createOpt.Name); err != nil {
+				return fmt.Errorf("failed to list instances: %w", err)
+			}
+		}
+
+		resp, err := httpClient.CreateInstance(cmd.Context(), zoneID, createOpt.ServiceOfferingID, createOpt.VMImageID, networkIds, createOpt.Name)
 		if err != nil {
 			slog.Error("failed to create instance", "error", err, "zoneID", zoneID)
-
-			// Try to parse API error response for 'errors' field
-			var apiErr struct {
-				Errors map[string][]string `json:"errors"`
-			}
-			if errBody, ok := err.(interface{ Error() string }); ok {
-				errStr := errBody.Error()
-				if json.Unmarshal([]byte(errStr), &apiErr) == nil && len(apiErr.Errors) > 0 {
-					fmt.Println("API Error:")
-					for field, msgs := range apiErr.Errors {
-						for _, msg := range msgs {
-							fmt.Printf("- %s: %s\n", field, msg)
-						}
-					}
-					return fmt.Errorf("API returned errors")
-				}
-			}
 			return fmt.Errorf("failed to create instance: %w", err)
 		}
-		if resp != nil && resp.Data.Success {
-			fmt.Println("Instance creation request accepted. Your instance will be created soon.")
-			fmt.Println("Please check the instance list to see when it becomes active.")
-		} else {
-			b, _ := json.MarshalIndent(resp, "", "  ")
-			fmt.Println(string(b))
+		if resp == nil || !resp.Data.Success {
+			slog.Error("instance creation unsuccessful", "response", resp)
+			return fmt.Errorf("instance creation failed")
+		}
+		if err := presenter.Result(resp, "Instance creation request accepted. Your instance will be created soon.\nPlease check the instance list to see when it becomes active."); err != nil {
+			return err
+		}
+		if createOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %q", createOpt.Name), poll, http.WaitOptions{
+				Target:  []string{http.InstanceStatusUp},
+				Timeout: createOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -242,5 +245,6 @@ var instanceCreateCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceCreateCmd)
+	cli.RequireAbilities(instanceCreateCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceCreateCmd, &createOpt)
 }
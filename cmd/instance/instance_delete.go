@@ -4,20 +4,24 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instance-id" usage:"ID of the instance to delete"`
-	Name        string `flag:"name" usage:"Name of the instance to delete"`
-	Interactive bool   `flag:"interactive" usage:"Run interactive instance deletion workflow"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instance-id" usage:"ID of the instance to delete"`
+	Name        string        `flag:"name" usage:"Name of the instance to delete"`
+	Interactive bool          `flag:"interactive" usage:"Run interactive instance deletion workflow"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var deleteOpt deleteOptions
@@ -47,11 +51,11 @@ var instanceDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 
 		if deleteOpt.Interactive {
 			// Interactive deletion flow
-			instanceListResp, err := httpClient.ListInstances(zoneID)
+			instanceListResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || instanceListResp == nil || len(instanceListResp.Data) == 0 {
 				slog.Error("failed to fetch instances", "error", err)
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -76,38 +80,30 @@ var instanceDeleteCmd = &cobra.Command{
 			}
 			selected := instanceListResp.Data[instIdx]
 
-			fmt.Printf("You have selected: %s (ID: %s)\n", selected.Name, selected.ID)
-			fmt.Print("Proceed with deletion? (y/n): ")
-			confirm, _ := reader.ReadString('\n')
-			confirm = strings.TrimSpace(confirm)
-			if strings.ToLower(confirm) != "y" {
-				fmt.Println("Aborted.")
-				return nil
-			}
+			deleteOpt.InstanceID = selected.ID
+			deleteOpt.Name = selected.Name
+		}
 
-			resp, err := httpClient.DeleteInstance(zoneID, selected.ID, selected.Name)
-			if err != nil {
-				slog.Error("failed to delete instance", "error", err, "zoneID", zoneID, "instanceID", selected.ID)
-				return fmt.Errorf("failed to delete instance: %w", err)
-			}
-			if resp.Data.Success {
-				fmt.Println("Instance deletion request accepted. Your instance will be deleted soon.")
-			} else {
-				fmt.Println("Instance deletion failed.")
-			}
-			return nil
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "instance", ID: deleteOpt.InstanceID, Name: deleteOpt.Name}); !ok {
+			return err
 		}
 
-		// Non-interactive mode
-		resp, err := httpClient.DeleteInstance(zoneID, deleteOpt.InstanceID, deleteOpt.Name)
+		resp, err := httpClient.DeleteInstance(cmd.Context(), zoneID, deleteOpt.InstanceID, deleteOpt.Name)
 		if err != nil {
 			slog.Error("failed to delete instance", "error", err, "zoneID", zoneID, "instanceID", deleteOpt.InstanceID)
 			return fmt.Errorf("failed to delete instance: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Instance deletion request accepted. Your instance will be deleted soon.")
-		} else {
-			fmt.Println("Instance deletion failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance deletion failed")
+		}
+		if err := presenter.Result(resp, "Instance deletion request accepted. Your instance will be deleted soon."); err != nil {
+			return err
+		}
+		if deleteOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %q", deleteOpt.Name), httpClient.PollInstance(zoneID, deleteOpt.InstanceID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   deleteOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -115,5 +111,6 @@ var instanceDeleteCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceDeleteCmd)
+	cli.RequireAbilities(instanceDeleteCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceDeleteCmd, &deleteOpt)
 }
@@ -3,11 +3,10 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -45,25 +44,24 @@ var instanceMetricsCmd = &cobra.Command{
 			metrics = []string{"memoryusedkbs", "cpuused"}
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetInstanceMetrics(zoneID, metricsOpt.InstanceID, metrics, metricsOpt.Time, metricsOpt.Aggregator)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetInstanceMetrics(cmd.Context(), zoneID, metricsOpt.InstanceID, metrics, metricsOpt.Time, metricsOpt.Aggregator)
 		if err != nil {
 			slog.Error("failed to get instance metrics", "error", err, "zoneID", zoneID, "instanceID", metricsOpt.InstanceID)
 			return fmt.Errorf("failed to get instance metrics: %w", err)
 		}
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"Metric", "Time", "Value"})
+		table := presenter.NewTable("Metric", "Time", "Value")
 		for _, col := range resp.Data {
 			for _, val := range col.Values {
-				table.Append([]string{col.Column, val.Time, fmt.Sprintf("%v", val.Value)})
+				table.Append(col.Column, val.Time, fmt.Sprintf("%v", val.Value))
 			}
 		}
-		table.Render()
-		return nil
+		return presenter.Output(resp, table)
 	},
 }
 
 func init() {
 	InstanceCmd.AddCommand(instanceMetricsCmd)
+	cli.RequireAbilities(instanceMetricsCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceMetricsCmd, &metricsOpt)
 }
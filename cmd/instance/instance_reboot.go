@@ -4,19 +4,23 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type rebootOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instance-id" usage:"ID of the instance to reboot"`
-	Interactive bool   `flag:"interactive" usage:"Run interactive instance reboot workflow"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instance-id" usage:"ID of the instance to reboot"`
+	Interactive bool          `flag:"interactive" usage:"Run interactive instance reboot workflow"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is UP again"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var rebootOpt rebootOptions
@@ -45,12 +49,12 @@ var instanceRebootCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 
 		instanceID := rebootOpt.InstanceID
 
 		if rebootOpt.Interactive {
-			instanceListResp, err := httpClient.ListInstances(zoneID)
+			instanceListResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || instanceListResp == nil || len(instanceListResp.Data) == 0 {
 				slog.Error("failed to fetch instances", "error", err)
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -86,15 +90,23 @@ var instanceRebootCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := httpClient.RebootInstance(zoneID, instanceID)
+		resp, err := httpClient.RebootInstance(cmd.Context(), zoneID, instanceID)
 		if err != nil {
 			slog.Error("failed to reboot instance", "error", err, "zoneID", zoneID, "instanceID", instanceID)
 			return fmt.Errorf("failed to reboot instance: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Instance reboot request accepted.")
-		} else {
-			fmt.Println("Instance reboot failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance reboot failed")
+		}
+		if err := presenter.Result(resp, "Instance reboot request accepted."); err != nil {
+			return err
+		}
+		if rebootOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %s", instanceID), httpClient.PollInstance(zoneID, instanceID), http.WaitOptions{
+				Target:  []string{http.InstanceStatusUp},
+				From:    []string{http.InstanceStatusUp},
+				Timeout: rebootOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -102,5 +114,6 @@ var instanceRebootCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceRebootCmd)
+	cli.RequireAbilities(instanceRebootCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceRebootCmd, &rebootOpt)
 }
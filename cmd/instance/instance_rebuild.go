@@ -3,16 +3,20 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type rebuildOptions struct {
-	ZoneID     string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID string `flag:"instance-id" usage:"ID of the instance to rebuild"`
-	VMImageID  string `flag:"vm-image-id" usage:"ID of the new VM image"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instance-id" usage:"ID of the instance to rebuild"`
+	VMImageID   string        `flag:"vm-image-id" usage:"ID of the new VM image"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is UP again"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var rebuildOpt rebuildOptions
@@ -37,17 +41,29 @@ var instanceRebuildCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.RebuildInstance(zoneID, rebuildOpt.InstanceID, rebuildOpt.VMImageID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		if ok, err := cli.Confirm(cmd.Context(), "rebuild, erasing all data on", cli.Instance.Target(rebuildOpt.InstanceID)); !ok {
+			return err
+		}
+
+		resp, err := httpClient.RebuildInstance(cmd.Context(), zoneID, rebuildOpt.InstanceID, rebuildOpt.VMImageID)
 		if err != nil {
 			slog.Error("failed to rebuild instance", "error", err, "zoneID", zoneID, "instanceID", rebuildOpt.InstanceID)
 			return fmt.Errorf("failed to rebuild instance: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("Instance rebuild request accepted.")
-		} else {
-			fmt.Println("Instance rebuild failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance rebuild failed")
+		}
+		if err := presenter.Result(resp, "Instance rebuild request accepted."); err != nil {
+			return err
+		}
+		if rebuildOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %s", rebuildOpt.InstanceID), httpClient.PollInstance(zoneID, rebuildOpt.InstanceID), http.WaitOptions{
+				Target:  []string{http.InstanceStatusUp},
+				From:    []string{http.InstanceStatusUp},
+				Timeout: rebuildOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -55,5 +71,6 @@ var instanceRebuildCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceRebuildCmd)
+	cli.RequireAbilities(instanceRebuildCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceRebuildCmd, &rebuildOpt)
 }
@@ -4,12 +4,11 @@ package instance
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
@@ -17,97 +16,88 @@ import (
 type serviceOfferingListOptions struct {
 	ZoneID    string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	Available bool   `flag:"available" usage:"Show only available service offerings"`
-	Columns   string `flag:"columns" usage:"Comma-separated list of columns to display"`
+	presenter.ListOptions
 }
 
 var soListOpt serviceOfferingListOptions
 
-var validColumns = map[string]struct {
-	Header string
-	Value  func(offering responses.InstanceServiceOffering) string
-}{
-	"id":        {"ID", func(o responses.InstanceServiceOffering) string { return o.ID }},
-	"name":      {"Name", func(o responses.InstanceServiceOffering) string { return o.Name }},
-	"category":  {"Category", func(o responses.InstanceServiceOffering) string { return o.Category }},
-	"cpu":       {"CPU", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.CPUCore) }},
-	"memory":    {"Memory (MB)", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.MemoryMB) }},
-	"storage":   {"Storage (GB)", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.RootDiskSizeGB) }},
-	"cpu_speed": {"CPU Speed (MHz)", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.CPUSpeedMHz) }},
-	"network":   {"Network Rate", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.NetworkRate) }},
-	"disk_iops": {"Disk IOPS", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%d", o.Hardware.DiskIOPS) }},
-	"suggested": {"Suggested", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.Suggested) }},
-	"available": {"Available", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.IsAvailable) }},
-	"public":    {"Public", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.IsPublic) }},
-	"image_req": {"Image Req.", func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.HasImageRequirement) }},
-	"price_up": {"Price Up", func(o responses.InstanceServiceOffering) string {
+// serviceOfferingColumns keeps the short column names this command accepted
+// before columns could be any field path.
+var serviceOfferingColumns = []presenter.Column[responses.InstanceServiceOffering]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "category", Header: "Category"},
+	{Path: "cpu", Header: "CPU", Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.CPUCore })},
+	{Path: "memory", Header: "Memory (MB)", Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.MemoryMB })},
+	{Path: "storage", Header: "Storage (GB)", Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.RootDiskSizeGB })},
+	{Path: "suggested", Header: "Suggested"},
+	{Path: "available", Header: "Available", Value: func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.IsAvailable) }},
+	{Path: "cpu_speed", Header: "CPU Speed (MHz)", Wide: true, Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.CPUSpeedMHz })},
+	{Path: "network", Header: "Network Rate", Wide: true, Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.NetworkRate })},
+	{Path: "disk_iops", Header: "Disk IOPS", Wide: true, Value: hardware(func(h *responses.InstanceServiceOfferingHardware) int { return h.DiskIOPS })},
+	{Path: "public", Header: "Public", Wide: true, Value: func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.IsPublic) }},
+	{Path: "image_req", Header: "Image Req.", Wide: true, Value: func(o responses.InstanceServiceOffering) string { return fmt.Sprintf("%t", o.HasImageRequirement) }},
+	{Path: "price_up", Header: "Price Up", Wide: true, Value: func(o responses.InstanceServiceOffering) string {
 		if o.HourlyPrice != nil {
 			return fmt.Sprintf("%d", o.HourlyPrice.Up)
 		}
 		return ""
 	}},
-	"price_down": {"Price Down", func(o responses.InstanceServiceOffering) string {
+	{Path: "price_down", Header: "Price Down", Wide: true, Value: func(o responses.InstanceServiceOffering) string {
 		if o.HourlyPrice != nil {
 			return fmt.Sprintf("%d", o.HourlyPrice.Down)
 		}
 		return ""
 	}},
-	"nodisc_up": {"NoDisc Up", func(o responses.InstanceServiceOffering) string {
+	{Path: "nodisc_up", Header: "NoDisc Up", Wide: true, Value: func(o responses.InstanceServiceOffering) string {
 		if o.HourlyPriceNoDiscount != nil {
 			return fmt.Sprintf("%d", o.HourlyPriceNoDiscount.Up)
 		}
 		return ""
 	}},
-	"nodisc_down": {"NoDisc Down", func(o responses.InstanceServiceOffering) string {
+	{Path: "nodisc_down", Header: "NoDisc Down", Wide: true, Value: func(o responses.InstanceServiceOffering) string {
 		if o.HourlyPriceNoDiscount != nil {
 			return fmt.Sprintf("%d", o.HourlyPriceNoDiscount.Down)
 		}
 		return ""
 	}},
-	"description": {"Description", func(o responses.InstanceServiceOffering) string {
-		if o.Description != nil {
-			return *o.Description
-		}
-		return ""
-	}},
 }
 
-var defaultColumns = []string{"id", "name", "category", "cpu", "memory", "storage", "suggested", "available"}
+// hardware shows a hardware figure of an offering, or nothing when the API
+// omits the hardware.
+func hardware(value func(*responses.InstanceServiceOfferingHardware) int) func(responses.InstanceServiceOffering) string {
+	return func(o responses.InstanceServiceOffering) string {
+		if o.Hardware == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d", value(o.Hardware))
+	}
+}
 
 var instanceServiceOfferingListCmd = &cobra.Command{
 	Use:     "service-offering-list",
 	Aliases: []string{"offering", "service-offering", "service-offerings"},
 	Short:   "List available service offerings for instances in a zone",
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		if err := cli.Preflight(true)(cmd, args); err != nil {
-			return err
-		}
-
-		// Validate columns if provided
-		userColsStr, _ := cmd.Flags().GetString("columns")
-		if userColsStr != "" {
-			userCols := SplitAndTrim(userColsStr)
-			invalid := []string{}
-			for _, col := range userCols {
-				if _, ok := validColumns[col]; !ok {
-					invalid = append(invalid, col)
-				}
-			}
-			if len(invalid) > 0 {
-				return fmt.Errorf("invalid column(s): %v", invalid)
-			}
-		}
-		return nil
+		return cli.Preflight(true)(cmd, args)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		token := cli.TokenFromContext(cmd.Context())
-		zoneID := cli.ZoneIDFromContext(cmd.Context())
-
 		if err := cli.LoadFromCobraFlags(cmd, &soListOpt); err != nil {
 			return err
 		}
+		if soListOpt.ListColumns {
+			return presenter.ListColumns(serviceOfferingColumns)
+		}
+		list, err := presenter.NewList(soListOpt.ListOptions, serviceOfferingColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
+		token := cli.TokenFromContext(cmd.Context())
+		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListInstanceServiceOfferings(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListInstanceServiceOfferings(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to list instance service offerings", "error", err, "zoneID", zoneID)
 			return fmt.Errorf("failed to list instance service offerings: %w", err)
@@ -123,35 +113,12 @@ var instanceServiceOfferingListCmd = &cobra.Command{
 			}
 			resp.Data = filtered
 		}
-
-		// Parse columns
-		selectedColumns := defaultColumns
-		if soListOpt.Columns != "" {
-			selectedColumns = SplitAndTrim(soListOpt.Columns)
-		}
-		renderInstanceServiceOfferings(resp, selectedColumns)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderInstanceServiceOfferings(resp *responses.InstanceServiceOfferingListResponse, columns []string) {
-	headers := []string{}
-	for _, col := range columns {
-		headers = append(headers, validColumns[col].Header)
-	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(headers)
-	for _, offering := range resp.Data {
-		row := []string{}
-		for _, col := range columns {
-			row = append(row, validColumns[col].Value(offering))
-		}
-		table.Append(row)
-	}
-	table.Render()
-}
-
 func init() {
 	InstanceCmd.AddCommand(instanceServiceOfferingListCmd)
+	cli.RequireAbilities(instanceServiceOfferingListCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceServiceOfferingListCmd, &soListOpt)
 }
@@ -8,10 +8,10 @@ import (
 	"strings"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -47,11 +47,11 @@ var instanceShowCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := showOpt.InstanceID
 
 		if showOpt.Interactive {
-			listResp, err := httpClient.ListInstances(zoneID)
+			listResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil {
 				return fmt.Errorf("could not fetch instance list: %w", err)
 			}
@@ -80,41 +80,41 @@ var instanceShowCmd = &cobra.Command{
 			instanceID = listResp.Data[selection-1].ID
 		}
 
-		resp, err := httpClient.ShowInstance(zoneID, instanceID)
+		resp, err := httpClient.ShowInstance(cmd.Context(), zoneID, instanceID)
 		if err != nil {
 			return fmt.Errorf("could not fetch instance details: %w", err)
 		}
 		if resp.Data.ID == "" {
 			return fmt.Errorf("instance not found")
 		}
-		renderInstanceDetails(resp.Data)
-		return nil
+		return renderInstanceDetails(resp)
 	},
 }
 
-func renderInstanceDetails(inst responses.Instance) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Field", "Value"})
-	table.Append([]string{"ID", inst.ID})
-	table.Append([]string{"Name", inst.Name})
-	table.Append([]string{"Status", inst.Status})
-	table.Append([]string{"Instance Status", inst.InstanceStatus})
-	table.Append([]string{"Zone ID", inst.ZoneID})
-	table.Append([]string{"Created At", fmt.Sprintf("%d", inst.CreatedAt)})
-	table.Append([]string{"Updated At", fmt.Sprintf("%d", inst.UpdatedAt)})
-	table.Append([]string{"Username", inst.Username})
-	table.Append([]string{"Password", inst.Password})
+func renderInstanceDetails(resp *responses.InstanceShowResponse) error {
+	inst := resp.Data
+	table := presenter.NewTable("Field", "Value")
+	table.Append("ID", inst.ID)
+	table.Append("Name", inst.Name)
+	table.Append("Status", inst.Status)
+	table.Append("Instance Status", inst.InstanceStatus)
+	table.Append("Zone ID", inst.ZoneID)
+	table.Append("Created At", fmt.Sprintf("%d", inst.CreatedAt))
+	table.Append("Updated At", fmt.Sprintf("%d", inst.UpdatedAt))
+	table.Append("Username", inst.Username)
+	table.Append("Password", inst.Password)
 	if inst.VMImage != nil {
-		table.Append([]string{"VM Image Name", inst.VMImage.Name})
-		table.Append([]string{"VM Image OS", inst.VMImage.OSName})
+		table.Append("VM Image Name", inst.VMImage.Name)
+		table.Append("VM Image OS", inst.VMImage.OSName)
 	}
 	if inst.ServiceOffering != nil {
-		table.Append([]string{"Service Offering", inst.ServiceOffering.Name})
+		table.Append("Service Offering", inst.ServiceOffering.Name)
 	}
-	table.Render()
+	return presenter.Output(resp, table)
 }
 
 func init() {
 	InstanceCmd.AddCommand(instanceShowCmd)
+	cli.RequireAbilities(instanceShowCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceShowCmd, &showOpt)
 }
@@ -4,12 +4,14 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -20,10 +22,12 @@ var instanceSnapshotCmd = &cobra.Command{
 }
 
 type snapshotCreateOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
-	Name        string `flag:"name" usage:"Snapshot name"`
-	Interactive bool   `flag:"interactive" usage:"Prompt for required fields interactively"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instanceId" usage:"Instance ID"`
+	Name        string        `flag:"name" usage:"Snapshot name"`
+	Interactive bool          `flag:"interactive" usage:"Prompt for required fields interactively"`
+	Wait        bool          `flag:"wait" usage:"Wait until the snapshot is READY"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var snapshotCreateOpt snapshotCreateOptions
@@ -52,7 +56,7 @@ var instanceSnapshotCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := snapshotCreateOpt.InstanceID
 		name := snapshotCreateOpt.Name
 
@@ -60,7 +64,7 @@ var instanceSnapshotCreateCmd = &cobra.Command{
 			reader := bufio.NewReader(os.Stdin)
 
 			// Fetch instances in zone
-			instanceListResp, err := httpClient.ListInstances(zoneID)
+			instanceListResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || instanceListResp == nil || len(instanceListResp.Data) == 0 {
 				slog.Error("failed to fetch instances", "error", err)
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -111,7 +115,7 @@ var instanceSnapshotCreateCmd = &cobra.Command{
 		}
 
 		// Check for WAITING snapshot before proceeding
-		instanceDetail, err := httpClient.ShowInstance(zoneID, instanceID)
+		instanceDetail, err := httpClient.ShowInstance(cmd.Context(), zoneID, instanceID)
 		if err != nil {
 			slog.Error("failed to fetch instance details", "error", err)
 			return fmt.Errorf("could not fetch instance details")
@@ -122,15 +126,32 @@ var instanceSnapshotCreateCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := httpClient.CreateInstanceSnapshot(zoneID, instanceID, name)
+		// The create request does not return the new ID, so the wait tells the
+		// new snapshot from older ones with the same name by the IDs listed now
+		var poll http.PollFunc
+		if snapshotCreateOpt.Wait {
+			var err error
+			if poll, err = httpClient.PollNewInstanceSnapshot(cmd.Context(), zoneID, instanceID, name); err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+		}
+
+		resp, err := httpClient.CreateInstanceSnapshot(cmd.Context(), zoneID, instanceID, name)
 		if err != nil {
 			slog.Error("failed to create snapshot", "error", err, "zoneID", zoneID, "instanceID", instanceID, "name", name)
 			return fmt.Errorf("failed to create snapshot: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Snapshot created successfully.")
-		} else {
-			fmt.Println("Snapshot creation failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("snapshot creation failed")
+		}
+		if err := presenter.Result(resp, "Snapshot created successfully."); err != nil {
+			return err
+		}
+		if snapshotCreateOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("snapshot %q", name), poll, http.WaitOptions{
+				Target:  []string{http.SnapshotStatusReady},
+				Timeout: snapshotCreateOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -139,5 +160,6 @@ var instanceSnapshotCreateCmd = &cobra.Command{
 func init() {
 	InstanceCmd.AddCommand(instanceSnapshotCmd)
 	instanceSnapshotCmd.AddCommand(instanceSnapshotCreateCmd)
+	cli.RequireAbilities(instanceSnapshotCreateCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceSnapshotCreateCmd, &snapshotCreateOpt)
 }
@@ -4,20 +4,24 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type snapshotDeleteOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
-	SnapshotID  string `flag:"snapshotId" usage:"Snapshot ID"`
-	Interactive bool   `flag:"interactive" usage:"Interactively select instance and snapshot"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instanceId" usage:"Instance ID"`
+	SnapshotID  string        `flag:"snapshotId" usage:"Snapshot ID"`
+	Interactive bool          `flag:"interactive" usage:"Interactively select instance and snapshot"`
+	Wait        bool          `flag:"wait" usage:"Wait until the snapshot is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var snapshotDeleteOpt snapshotDeleteOptions
@@ -46,7 +50,7 @@ var instanceSnapshotDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := snapshotDeleteOpt.InstanceID
 		snapshotID := snapshotDeleteOpt.SnapshotID
 
@@ -54,7 +58,7 @@ var instanceSnapshotDeleteCmd = &cobra.Command{
 			reader := bufio.NewReader(os.Stdin)
 
 			// Fetch instances
-			instancesResp, err := httpClient.ListInstances(zoneID)
+			instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || len(instancesResp.Data) == 0 {
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
 			}
@@ -77,7 +81,7 @@ var instanceSnapshotDeleteCmd = &cobra.Command{
 			instanceID = instancesResp.Data[instIdx].ID
 
 			// Fetch snapshots for selected instance
-			snapshotsResp, err := httpClient.ShowInstance(zoneID, instanceID)
+			snapshotsResp, err := httpClient.ShowInstance(cmd.Context(), zoneID, instanceID)
 			if err != nil || len(snapshotsResp.Data.Snapshot) == 0 {
 				return fmt.Errorf("could not fetch snapshots or no snapshots found for this instance")
 			}
@@ -100,15 +104,26 @@ var instanceSnapshotDeleteCmd = &cobra.Command{
 			snapshotID = snapshotsResp.Data.Snapshot[snapIdx].ID
 		}
 
-		resp, err := httpClient.DeleteInstanceSnapshot(zoneID, instanceID, snapshotID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "snapshot", ID: snapshotID}); !ok {
+			return err
+		}
+
+		resp, err := httpClient.DeleteInstanceSnapshot(cmd.Context(), zoneID, instanceID, snapshotID)
 		if err != nil {
 			slog.Error("failed to delete snapshot", "error", err, "zoneId", zoneID, "instanceId", instanceID, "snapshotId", snapshotID)
 			return fmt.Errorf("failed to delete snapshot: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Snapshot deleted successfully.")
-		} else {
-			fmt.Println("Snapshot delete failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("snapshot delete failed")
+		}
+		if err := presenter.Result(resp, "Snapshot deleted successfully."); err != nil {
+			return err
+		}
+		if snapshotDeleteOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("snapshot %s", snapshotID), httpClient.PollInstanceSnapshot(zoneID, instanceID, snapshotID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   snapshotDeleteOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -116,5 +131,6 @@ var instanceSnapshotDeleteCmd = &cobra.Command{
 
 func init() {
 	instanceSnapshotCmd.AddCommand(instanceSnapshotDeleteCmd)
+	cli.RequireAbilities(instanceSnapshotDeleteCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceSnapshotDeleteCmd, &snapshotDeleteOpt)
 }
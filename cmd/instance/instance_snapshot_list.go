@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
@@ -11,7 +12,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +19,7 @@ type snapshotListOptions struct {
 	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
 	Interactive bool   `flag:"interactive" usage:"Interactively select instance"`
+	presenter.ListOptions
 }
 
 var snapshotListOpt snapshotListOptions
@@ -45,13 +46,20 @@ var instanceSnapshotListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &snapshotListOpt); err != nil {
 			return err
 		}
+		if snapshotListOpt.ListColumns {
+			return presenter.ListColumns(snapshotColumns)
+		}
+		list, err := presenter.NewList(snapshotListOpt.ListOptions, snapshotColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := snapshotListOpt.InstanceID
 
 		if snapshotListOpt.Interactive {
 			reader := bufio.NewReader(os.Stdin)
-			instancesResp, err := httpClient.ListInstances(zoneID)
+			instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || len(instancesResp.Data) == 0 {
 				slog.Error("could not fetch instances or no instances found in this zone")
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -73,53 +81,35 @@ var instanceSnapshotListCmd = &cobra.Command{
 				fmt.Println("Invalid selection. Try again.")
 			}
 			instanceID = instancesResp.Data[instIdx].ID
-			renderInstanceSnapshots(instancesResp.Data[instIdx].Snapshot)
-			return nil
+			snapshots := instancesResp.Data[instIdx].Snapshot
+			return list.Output(snapshots, snapshots)
 		}
 
 		// Non-interactive mode
-		instancesResp, err := httpClient.ListInstances(zoneID)
+		instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 		if err != nil || len(instancesResp.Data) == 0 {
 			return fmt.Errorf("could not fetch instances or no instances found in this zone")
 		}
-		var found bool
 		for _, inst := range instancesResp.Data {
 			if inst.ID == instanceID {
-				renderInstanceSnapshots(inst.Snapshot)
-				found = true
-				break
+				return list.Output(inst.Snapshot, inst.Snapshot)
 			}
 		}
-		if !found {
-			return fmt.Errorf("instance not found in this zone")
-		}
-		return nil
+		return fmt.Errorf("instance not found in this zone")
 	},
 }
 
-func renderInstanceSnapshots(snapshots []responses.InstanceSnapshot) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Status", "CreatedAt", "Current", "ParentID"})
-	for _, snap := range snapshots {
-		created := fmt.Sprintf("%d", snap.CreatedAt)
-		current := fmt.Sprintf("%t", snap.Current)
-		parent := ""
-		if snap.ParentID != nil {
-			parent = *snap.ParentID
-		}
-		table.Append([]string{
-			snap.ID,
-			snap.Name,
-			snap.Status,
-			created,
-			current,
-			parent,
-		})
-	}
-	table.Render()
+var snapshotColumns = []presenter.Column[responses.InstanceSnapshot]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "status", Header: "Status"},
+	{Path: "created_at", Header: "CreatedAt"},
+	{Path: "current", Header: "Current"},
+	{Path: "parent_id", Header: "ParentID"},
 }
 
 func init() {
 	instanceSnapshotCmd.AddCommand(instanceSnapshotListCmd)
+	cli.RequireAbilities(instanceSnapshotListCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceSnapshotListCmd, &snapshotListOpt)
 }
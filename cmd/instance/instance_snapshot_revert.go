@@ -4,21 +4,25 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type snapshotRevertOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
-	SnapshotID  string `flag:"snapshotId" usage:"Snapshot ID"`
-	Interactive bool   `flag:"interactive" usage:"Interactively select instance and snapshot"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instanceId" usage:"Instance ID"`
+	SnapshotID  string        `flag:"snapshotId" usage:"Snapshot ID"`
+	Interactive bool          `flag:"interactive" usage:"Interactively select instance and snapshot"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is reverted and UP or DOWN"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var snapshotRevertOpt snapshotRevertOptions
@@ -47,13 +51,13 @@ var instanceSnapshotRevertCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := snapshotRevertOpt.InstanceID
 		snapshotID := snapshotRevertOpt.SnapshotID
 
 		if snapshotRevertOpt.Interactive {
 			reader := bufio.NewReader(os.Stdin)
-			instancesResp, err := httpClient.ListInstances(zoneID)
+			instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || len(instancesResp.Data) == 0 {
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
 			}
@@ -104,15 +108,26 @@ var instanceSnapshotRevertCmd = &cobra.Command{
 			snapshotID = readySnapshots[snapIdx].ID
 		}
 
-		resp, err := httpClient.RevertInstanceSnapshot(zoneID, instanceID, snapshotID)
+		if ok, err := cli.Confirm(cmd.Context(), "revert to snapshot "+snapshotID, cli.Instance.Target(instanceID)); !ok {
+			return err
+		}
+
+		resp, err := httpClient.RevertInstanceSnapshot(cmd.Context(), zoneID, instanceID, snapshotID)
 		if err != nil {
 			slog.Error("failed to revert snapshot", "error", err, "zoneId", zoneID, "instanceId", instanceID, "snapshotId", snapshotID)
 			return fmt.Errorf("failed to revert snapshot: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Instance going to revert to snapshot. This may take a few minutes.")
-		} else {
-			fmt.Println("Instance revert to snapshot failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance revert to snapshot failed")
+		}
+		if err := presenter.Result(resp, "Instance going to revert to snapshot. This may take a few minutes."); err != nil {
+			return err
+		}
+		if snapshotRevertOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %s", instanceID), httpClient.PollInstanceRevert(zoneID, instanceID, snapshotID), http.WaitOptions{
+				Target:  []string{http.InstanceStatusUp, http.InstanceStatusDown},
+				Timeout: snapshotRevertOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -120,5 +135,6 @@ var instanceSnapshotRevertCmd = &cobra.Command{
 
 func init() {
 	instanceSnapshotCmd.AddCommand(instanceSnapshotRevertCmd)
+	cli.RequireAbilities(instanceSnapshotRevertCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceSnapshotRevertCmd, &snapshotRevertOpt)
 }
@@ -4,20 +4,24 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type startOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instance-id" usage:"ID of the instance to start"`
-	Interactive bool   `flag:"interactive" usage:"Run interactive instance start workflow"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instance-id" usage:"ID of the instance to start"`
+	Interactive bool          `flag:"interactive" usage:"Run interactive instance start workflow"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is UP"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var startOpt startOptions
@@ -46,11 +50,11 @@ var instanceStartCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := startOpt.InstanceID
 
 		if startOpt.Interactive {
-			instanceListResp, err := httpClient.ListInstances(zoneID)
+			instanceListResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || instanceListResp == nil || len(instanceListResp.Data) == 0 {
 				slog.Error("failed to fetch instances", "error", err)
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -98,15 +102,22 @@ var instanceStartCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := httpClient.StartInstance(zoneID, instanceID)
+		resp, err := httpClient.StartInstance(cmd.Context(), zoneID, instanceID)
 		if err != nil {
 			slog.Error("failed to start instance", "error", err, "zoneID", zoneID, "instanceID", instanceID)
 			return fmt.Errorf("failed to start instance: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Instance start request accepted.")
-		} else {
-			fmt.Println("Instance start failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance start failed")
+		}
+		if err := presenter.Result(resp, "Instance start request accepted."); err != nil {
+			return err
+		}
+		if startOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %s", instanceID), httpClient.PollInstance(zoneID, instanceID), http.WaitOptions{
+				Target:  []string{http.InstanceStatusUp},
+				Timeout: startOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -114,5 +125,6 @@ var instanceStartCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceStartCmd)
+	cli.RequireAbilities(instanceStartCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceStartCmd, &startOpt)
 }
@@ -4,21 +4,25 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type stopOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	InstanceID  string `flag:"instance-id" usage:"ID of the instance to stop"`
-	Forced      bool   `flag:"forced" usage:"Force stop the instance"`
-	Interactive bool   `flag:"interactive" usage:"Run interactive instance stop workflow"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	InstanceID  string        `flag:"instance-id" usage:"ID of the instance to stop"`
+	Forced      bool          `flag:"forced" usage:"Force stop the instance"`
+	Interactive bool          `flag:"interactive" usage:"Run interactive instance stop workflow"`
+	Wait        bool          `flag:"wait" usage:"Wait until the instance is DOWN"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var stopOpt stopOptions
@@ -47,11 +51,11 @@ var instanceStopCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		instanceID := stopOpt.InstanceID
 
 		if stopOpt.Interactive {
-			instanceListResp, err := httpClient.ListInstances(zoneID)
+			instanceListResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || instanceListResp == nil || len(instanceListResp.Data) == 0 {
 				slog.Error("failed to fetch instances", "error", err)
 				return fmt.Errorf("could not fetch instances or no instances found in this zone")
@@ -99,15 +103,22 @@ var instanceStopCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := httpClient.StopInstance(zoneID, instanceID, stopOpt.Forced)
+		resp, err := httpClient.StopInstance(cmd.Context(), zoneID, instanceID, stopOpt.Forced)
 		if err != nil {
 			slog.Error("failed to stop instance", "error", err, "zoneID", zoneID, "instanceID", instanceID)
 			return fmt.Errorf("failed to stop instance: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Instance stop request accepted.")
-		} else {
-			fmt.Println("Instance stop failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("instance stop failed")
+		}
+		if err := presenter.Result(resp, "Instance stop request accepted."); err != nil {
+			return err
+		}
+		if stopOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("instance %s", instanceID), httpClient.PollInstance(zoneID, instanceID), http.WaitOptions{
+				Target:  []string{http.InstanceStatusDown},
+				Timeout: stopOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -115,5 +126,6 @@ var instanceStopCmd = &cobra.Command{
 
 func init() {
 	InstanceCmd.AddCommand(instanceStopCmd)
+	cli.RequireAbilities(instanceStopCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceStopCmd, &stopOpt)
 }
@@ -3,18 +3,18 @@ package instance
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type vmImageListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var vmImageListOpt vmImageListOptions
@@ -31,36 +31,36 @@ var instanceVMImageListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &vmImageListOpt); err != nil {
 			return err
 		}
+		if vmImageListOpt.ListColumns {
+			return presenter.ListColumns(vmImageColumns)
+		}
+		list, err := presenter.NewList(vmImageListOpt.ListOptions, vmImageColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListInstanceVMImages(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListInstanceVMImages(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to list instance VM images", "error", err, "zoneID", zoneID)
 			return fmt.Errorf("failed to list instance VM images: %w", err)
 		}
-		renderInstanceVMImages(resp)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderInstanceVMImages(resp *responses.InstanceVMImageListResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Type", "OS Name", "OS Version", "Available", "Category"})
-	for _, image := range resp.Data {
-		table.Append([]string{
-			image.ID,
-			image.Name,
-			image.Type,
-			image.OSName,
-			image.OSVersion,
-			fmt.Sprintf("%t", image.IsAvailable),
-			image.Category,
-		})
-	}
-	table.Render()
+var vmImageColumns = []presenter.Column[responses.InstanceVMImage]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "type", Header: "Type"},
+	{Path: "os_name", Header: "OS Name"},
+	{Path: "os_version", Header: "OS Version"},
+	{Path: "is_available", Header: "Available"},
+	{Path: "category", Header: "Category"},
 }
 
 func init() {
 	InstanceCmd.AddCommand(instanceVMImageListCmd)
+	cli.RequireAbilities(instanceVMImageListCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceVMImageListCmd, &vmImageListOpt)
 }
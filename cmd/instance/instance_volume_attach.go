@@ -4,20 +4,24 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type volumeAttachOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	VolumeID    string `flag:"volumeId" usage:"Volume ID"`
-	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
-	Interactive bool   `flag:"interactive" usage:"Interactively select volume and instance"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	VolumeID    string        `flag:"volumeId" usage:"Volume ID"`
+	InstanceID  string        `flag:"instanceId" usage:"Instance ID"`
+	Interactive bool          `flag:"interactive" usage:"Interactively select volume and instance"`
+	Wait        bool          `flag:"wait" usage:"Wait until the volume is ready again"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var volumeAttachOpt volumeAttachOptions
@@ -46,7 +50,7 @@ var instanceVolumeAttachCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		volumeID := volumeAttachOpt.VolumeID
 		instanceID := volumeAttachOpt.InstanceID
 
@@ -54,7 +58,7 @@ var instanceVolumeAttachCmd = &cobra.Command{
 			reader := bufio.NewReader(os.Stdin)
 
 			// Select Volume
-			volumesResp, err := httpClient.ListInstanceVolumes(zoneID)
+			volumesResp, err := httpClient.ListInstanceVolumes(cmd.Context(), zoneID)
 			if err != nil || len(volumesResp.Data) == 0 {
 				return fmt.Errorf("no volumes found or error fetching volumes")
 			}
@@ -77,7 +81,7 @@ var instanceVolumeAttachCmd = &cobra.Command{
 			volumeID = volumesResp.Data[volChoice].ID
 
 			// Select Instance
-			instancesResp, err := httpClient.ListInstances(zoneID)
+			instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || len(instancesResp.Data) == 0 {
 				return fmt.Errorf("no instances found or error fetching instances")
 			}
@@ -110,15 +114,22 @@ var instanceVolumeAttachCmd = &cobra.Command{
 			return fmt.Errorf("--volumeId and --instanceId flags are required")
 		}
 
-		resp, err := httpClient.AttachInstanceVolume(zoneID, volumeID, instanceID)
+		resp, err := httpClient.AttachInstanceVolume(cmd.Context(), zoneID, volumeID, instanceID)
 		if err != nil {
 			slog.Error("failed to attach volume", "error", err, "zoneId", zoneID, "volumeId", volumeID, "instanceId", instanceID)
 			return fmt.Errorf("failed to attach volume: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Volume attached successfully.")
-		} else {
-			fmt.Println("Volume attach failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("volume attach failed")
+		}
+		if err := presenter.Result(resp, "Volume attached successfully."); err != nil {
+			return err
+		}
+		if volumeAttachOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("volume %s", volumeID), httpClient.PollInstanceVolume(zoneID, volumeID), http.WaitOptions{
+				Target:  []string{http.VolumeStatusReady},
+				Timeout: volumeAttachOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -126,5 +137,6 @@ var instanceVolumeAttachCmd = &cobra.Command{
 
 func init() {
 	instanceVolumeCmd.AddCommand(instanceVolumeAttachCmd)
+	cli.RequireAbilities(instanceVolumeAttachCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceVolumeAttachCmd, &volumeAttachOpt)
 }
@@ -4,11 +4,13 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -19,11 +21,13 @@ var instanceVolumeCmd = &cobra.Command{
 }
 
 type volumeCreateOptions struct {
-	ZoneID            string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	ServiceOfferingID string `flag:"serviceOfferingId" usage:"Service Offering ID"`
-	Size              int    `flag:"size" usage:"Volume size (GB)"`
-	Name              string `flag:"name" usage:"Volume name"`
-	Interactive       bool   `flag:"interactive" usage:"Interactively select service offering, size, and name"`
+	ZoneID            string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	ServiceOfferingID string        `flag:"serviceOfferingId" usage:"Service Offering ID"`
+	Size              int           `flag:"size" usage:"Volume size (GB)"`
+	Name              string        `flag:"name" usage:"Volume name"`
+	Interactive       bool          `flag:"interactive" usage:"Interactively select service offering, size, and name"`
+	Wait              bool          `flag:"wait" usage:"Wait until the volume is ready"`
+	WaitTimeout       time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var volumeCreateOpt volumeCreateOptions
@@ -53,14 +57,14 @@ var instanceVolumeCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		serviceOfferingID := volumeCreateOpt.ServiceOfferingID
 		size := volumeCreateOpt.Size
 		name := volumeCreateOpt.Name
 
 		if volumeCreateOpt.Interactive {
 			reader := bufio.NewReader(os.Stdin)
-			serviceOfferingsResp, err := httpClient.ListInstanceVolumeServiceOfferings(zoneID)
+			serviceOfferingsResp, err := httpClient.ListInstanceVolumeServiceOfferings(cmd.Context(), zoneID)
 			if err != nil || len(serviceOfferingsResp.Data) == 0 {
 				return fmt.Errorf("no volume service offerings found or error fetching offerings")
 			}
@@ -113,13 +117,21 @@ var instanceVolumeCreateCmd = &cobra.Command{
 			return fmt.Errorf("--serviceOfferingId, --size (>0), and --name flags are required in non-interactive mode")
 		}
 
-		resp, err := httpClient.CreateInstanceVolume(zoneID, serviceOfferingID, size, name)
+		resp, err := httpClient.CreateInstanceVolume(cmd.Context(), zoneID, serviceOfferingID, size, name)
 		if err != nil {
 			slog.Error("failed to create volume", "error", err, "zoneID", zoneID, "serviceOfferingID", serviceOfferingID, "size", size, "name", name)
 			return fmt.Errorf("failed to create volume: %w", err)
 		}
-		fmt.Printf("Volume created: ID=%s, Name=%s, Size=%d, Status=%s\n",
-			resp.Data.ID, resp.Data.Name, resp.Data.Size, resp.Data.Status)
+		if err := presenter.Result(resp, "Volume created: ID=%s, Name=%s, Size=%d, Status=%s",
+			resp.Data.ID, resp.Data.Name, resp.Data.Size, resp.Data.Status); err != nil {
+			return err
+		}
+		if volumeCreateOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("volume %q", resp.Data.Name), httpClient.PollInstanceVolume(zoneID, resp.Data.ID), http.WaitOptions{
+				Target:  []string{http.VolumeStatusAllocated, http.VolumeStatusReady},
+				Timeout: volumeCreateOpt.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
@@ -127,5 +139,6 @@ var instanceVolumeCreateCmd = &cobra.Command{
 func init() {
 	InstanceCmd.AddCommand(instanceVolumeCmd)
 	instanceVolumeCmd.AddCommand(instanceVolumeCreateCmd)
+	cli.RequireAbilities(instanceVolumeCreateCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceVolumeCreateCmd, &volumeCreateOpt)
 }
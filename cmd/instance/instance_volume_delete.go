@@ -4,19 +4,23 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type volumeDeleteOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	VolumeID    string `flag:"volumeId" usage:"Volume ID"`
-	Interactive bool   `flag:"interactive" usage:"Interactively select volume to delete"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	VolumeID    string        `flag:"volumeId" usage:"Volume ID"`
+	Interactive bool          `flag:"interactive" usage:"Interactively select volume to delete"`
+	Wait        bool          `flag:"wait" usage:"Wait until the volume is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var volumeDeleteOpt volumeDeleteOptions
@@ -44,12 +48,12 @@ var instanceVolumeDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		volumeID := volumeDeleteOpt.VolumeID
 
 		if volumeDeleteOpt.Interactive {
 			reader := bufio.NewReader(os.Stdin)
-			volumesResp, err := httpClient.ListInstanceVolumes(zoneID)
+			volumesResp, err := httpClient.ListInstanceVolumes(cmd.Context(), zoneID)
 			if err != nil || len(volumesResp.Data) == 0 {
 				return fmt.Errorf("no volumes found or error fetching volumes")
 			}
@@ -76,15 +80,26 @@ var instanceVolumeDeleteCmd = &cobra.Command{
 			return fmt.Errorf("--volumeId flag is required")
 		}
 
-		resp, err := httpClient.DeleteInstanceVolume(zoneID, volumeID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Volume.Target(volumeID)); !ok {
+			return err
+		}
+
+		resp, err := httpClient.DeleteInstanceVolume(cmd.Context(), zoneID, volumeID)
 		if err != nil {
 			slog.Error("failed to delete volume", "error", err, "zoneID", zoneID, "volumeID", volumeID)
 			return fmt.Errorf("failed to delete volume: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Volume deleted successfully.")
-		} else {
-			fmt.Println("Volume delete failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("volume delete failed")
+		}
+		if err := presenter.Result(resp, "Volume deleted successfully."); err != nil {
+			return err
+		}
+		if volumeDeleteOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("volume %s", volumeID), httpClient.PollInstanceVolume(zoneID, volumeID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   volumeDeleteOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -92,5 +107,6 @@ var instanceVolumeDeleteCmd = &cobra.Command{
 
 func init() {
 	instanceVolumeCmd.AddCommand(instanceVolumeDeleteCmd)
+	cli.RequireAbilities(instanceVolumeDeleteCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceVolumeDeleteCmd, &volumeDeleteOpt)
 }
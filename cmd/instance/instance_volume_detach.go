@@ -4,20 +4,24 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type volumeDetachOptions struct {
-	ZoneID      string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	VolumeID    string `flag:"volumeId" usage:"Volume ID"`
-	InstanceID  string `flag:"instanceId" usage:"Instance ID"`
-	Interactive bool   `flag:"interactive" usage:"Interactively select volume and instance to detach"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	VolumeID    string        `flag:"volumeId" usage:"Volume ID"`
+	InstanceID  string        `flag:"instanceId" usage:"Instance ID"`
+	Interactive bool          `flag:"interactive" usage:"Interactively select volume and instance to detach"`
+	Wait        bool          `flag:"wait" usage:"Wait until the volume is ready again"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var volumeDetachOpt volumeDetachOptions
@@ -46,7 +50,7 @@ var instanceVolumeDetachCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		volumeID := volumeDetachOpt.VolumeID
 		instanceID := volumeDetachOpt.InstanceID
 
@@ -54,7 +58,7 @@ var instanceVolumeDetachCmd = &cobra.Command{
 			reader := bufio.NewReader(os.Stdin)
 
 			// Select Volume
-			volumesResp, err := httpClient.ListInstanceVolumes(zoneID)
+			volumesResp, err := httpClient.ListInstanceVolumes(cmd.Context(), zoneID)
 			if err != nil || len(volumesResp.Data) == 0 {
 				return fmt.Errorf("no volumes found or error fetching volumes")
 			}
@@ -77,7 +81,7 @@ var instanceVolumeDetachCmd = &cobra.Command{
 			volumeID = volumesResp.Data[volChoice].ID
 
 			// Select Instance
-			instancesResp, err := httpClient.ListInstances(zoneID)
+			instancesResp, err := httpClient.ListInstances(cmd.Context(), zoneID)
 			if err != nil || len(instancesResp.Data) == 0 {
 				return fmt.Errorf("no instances found or error fetching instances")
 			}
@@ -104,15 +108,22 @@ var instanceVolumeDetachCmd = &cobra.Command{
 			return fmt.Errorf("--volumeId and --instanceId flags are required")
 		}
 
-		resp, err := httpClient.DetachInstanceVolume(zoneID, volumeID, instanceID)
+		resp, err := httpClient.DetachInstanceVolume(cmd.Context(), zoneID, volumeID, instanceID)
 		if err != nil {
 			slog.Error("failed to detach volume", "error", err, "zoneId", zoneID, "volumeId", volumeID, "instanceId", instanceID)
 			return fmt.Errorf("failed to detach volume: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("Volume detached successfully.")
-		} else {
-			fmt.Println("Volume detach failed.")
+		if !resp.Data.Success {
+			return fmt.Errorf("volume detach failed")
+		}
+		if err := presenter.Result(resp, "Volume detached successfully."); err != nil {
+			return err
+		}
+		if volumeDetachOpt.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("volume %s", volumeID), httpClient.PollInstanceVolume(zoneID, volumeID), http.WaitOptions{
+				Target:  []string{http.VolumeStatusAllocated, http.VolumeStatusReady},
+				Timeout: volumeDetachOpt.WaitTimeout,
+			})
 		}
 		return nil
 	},
@@ -120,5 +131,6 @@ var instanceVolumeDetachCmd = &cobra.Command{
 
 func init() {
 	instanceVolumeCmd.AddCommand(instanceVolumeDetachCmd)
+	cli.RequireAbilities(instanceVolumeDetachCmd, "instance:write")
 	_ = cli.BindFlagsFromStruct(instanceVolumeDetachCmd, &volumeDetachOpt)
 }
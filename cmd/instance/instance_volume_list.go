@@ -3,17 +3,17 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type volumeListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var volumeListOpt volumeListOptions
@@ -31,33 +31,33 @@ var instanceVolumeListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &volumeListOpt); err != nil {
 			return err
 		}
+		if volumeListOpt.ListColumns {
+			return presenter.ListColumns(volumeColumns)
+		}
+		list, err := presenter.NewList(volumeListOpt.ListOptions, volumeColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListInstanceVolumes(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListInstanceVolumes(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to list volumes", "error", err, "zoneID", zoneID)
 			return fmt.Errorf("failed to list volumes: %w", err)
 		}
-		renderInstanceVolumes(resp)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderInstanceVolumes(resp *responses.InstanceVolumeListResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Size", "Status"})
-	for _, vol := range resp.Data {
-		table.Append([]string{
-			vol.ID,
-			vol.Name,
-			fmt.Sprintf("%d", vol.Size),
-			vol.Status,
-		})
-	}
-	table.Render()
+var volumeColumns = []presenter.Column[responses.InstanceVolume]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "size", Header: "Size"},
+	{Path: "status", Header: "Status"},
 }
 
 func init() {
 	instanceVolumeCmd.AddCommand(instanceVolumeListCmd)
+	cli.RequireAbilities(instanceVolumeListCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceVolumeListCmd, &volumeListOpt)
 }
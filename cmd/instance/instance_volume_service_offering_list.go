@@ -3,17 +3,17 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type volumeServiceOfferingListOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var volumeSoListOpt volumeServiceOfferingListOptions
@@ -32,36 +32,36 @@ var instanceVolumeServiceOfferingListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &volumeSoListOpt); err != nil {
 			return err
 		}
+		if volumeSoListOpt.ListColumns {
+			return presenter.ListColumns(volumeServiceOfferingColumns)
+		}
+		list, err := presenter.NewList(volumeSoListOpt.ListOptions, volumeServiceOfferingColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListInstanceVolumeServiceOfferings(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListInstanceVolumeServiceOfferings(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to list volume service offerings", "error", err, "zoneID", zoneID)
 			return fmt.Errorf("failed to list volume service offerings: %w", err)
 		}
-		renderInstanceVolumeServiceOfferings(resp)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
-func renderInstanceVolumeServiceOfferings(resp *responses.InstanceVolumeServiceOfferingListResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Description", "Size", "Price", "Public", "Featured"})
-	for _, offering := range resp.Data {
-		table.Append([]string{
-			offering.ID,
-			offering.Name,
-			offering.Description,
-			offering.Size,
-			offering.Price,
-			fmt.Sprintf("%v", offering.IsPublic),
-			fmt.Sprintf("%v", offering.IsFeatured),
-		})
-	}
-	table.Render()
+var volumeServiceOfferingColumns = []presenter.Column[responses.InstanceVolumeServiceOffering]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "description", Header: "Description"},
+	{Path: "size", Header: "Size"},
+	{Path: "price", Header: "Price"},
+	{Path: "is_public", Header: "Public"},
+	{Path: "is_featured", Header: "Featured"},
 }
 
 func init() {
 	instanceVolumeCmd.AddCommand(instanceVolumeServiceOfferingListCmd)
+	cli.RequireAbilities(instanceVolumeServiceOfferingListCmd, "instance:read")
 	_ = cli.BindFlagsFromStruct(instanceVolumeServiceOfferingListCmd, &volumeSoListOpt)
 }
@@ -2,91 +2,233 @@ package cmd
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"github.com/virak-cloud/cli/cmd/auth"
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	urls "github.com/virak-cloud/cli/pkg"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/denisbrodbeck/machineid"
 	"github.com/pkg/browser"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 
 	"github.com/spf13/cobra"
 )
 
+type loginOptions struct {
+	TokenFile  string        `flag:"token-file" usage:"Read the token from a file instead of logging in with the browser"`
+	TokenStdin bool          `flag:"token-stdin" usage:"Read the token from stdin, e.g. from a pipe in provisioning scripts"`
+	NoBrowser  bool          `flag:"no-browser" usage:"Print the login URL instead of opening a browser"`
+	Timeout    time.Duration `flag:"timeout" default:"2m" usage:"How long to wait for the browser to hand the token back before asking to paste it"`
+}
+
+var loginOpt loginOptions
+
 // loginCmd represents the login command
 var loginCmd = &cobra.Command{
 	Use:     "login",
-	Aliases: []string{"log-in", "auth"},
+	Aliases: []string{"log-in"},
 	Short:   "Login to the Virak Cloud API",
-	Long:    `Login command allows you to authenticate with the Virak Cloud API.`,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		token, _ := cmd.Flags().GetString("token")
-		if token == "" {
-			var machineID string
-			if id, errMachineID := machineid.ID(); errMachineID != nil {
-				slog.Error("failed to get machine-id", "error", errMachineID)
-				fmt.Println("Failed to get machine-id:", errMachineID)
-				machineID = "unknown-machine-id"
-			} else {
-				machineID = id
-			}
-			// create a machine-id of this machine
-			url := fmt.Sprintf(urls.LoginUrl, machineID)
-			err := browser.OpenURL(string(url))
-			if err != nil {
-				slog.Error("failed to open browser", "error", err)
-				fmt.Println("Failed to open browser:", err)
-			}
-
-			fmt.Println("Please log in to the Virak Cloud API in your browser.")
-			fmt.Println("After logging in, you will receive a token. Use this token to authenticate future requests.")
-			fmt.Println("You can also use the --token flag to provide your token directly.")
-			fmt.Println("For more information, visit the Virak Cloud documentation. \n\n  ")
-			fmt.Println("Enter token: ")
-			reader := bufio.NewReader(os.Stdin)
-			inputToken, err := reader.ReadString('\n')
-			if err != nil {
-				slog.Error("failed to get token from user", "error", err)
-				fmt.Println("Failed to get token from user")
-				return
-			}
-			token = inputToken
-			token = strings.TrimSpace(token)
+	Long: `Login command allows you to authenticate with the Virak Cloud API.
+
+By default a browser opens the Virak panel to create a token. The CLI listens
+on 127.0.0.1 for the panel to hand the token back; if nothing arrives within
+--timeout, it asks you to paste the token instead, which also works at any
+time before. Where no browser is available, e.g. over SSH, the URL is printed;
+open it anywhere and paste the created token.
+
+The supported way to log in from scripts is to pass a token created in the
+panel with --token-stdin, or with --token-file, --token or VIRAK_TOKEN.`,
+	Example: `  virak-cli login
+  virak-cli login --no-browser
+  virak-cli login --token-file ~/.secrets/virak-token
+  vault kv get -field=token secret/virak | virak-cli login --token-stdin`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &loginOpt); err != nil {
+			return err
 		}
 
-		client := http.NewClient(token)
-		_, err := client.GetTokenAbilities()
+		profile := cli.ActiveProfile()
+		if err := cli.ValidateProfileName(profile); err != nil {
+			return err
+		}
 
+		token, err := loginToken(cmd.Context())
 		if err != nil {
+			slog.Error("failed to get token", "error", err)
+			return err
+		}
+
+		client := http.NewClient(token, cli.ClientOptions()...)
+		if _, err := client.GetTokenAbilities(cmd.Context()); err != nil {
 			slog.Error("failed to login with the provided token", "error", err)
-			fmt.Println("Failed to login with the provided token. Please check your token and try again.")
-			return
+			return fmt.Errorf("failed to login with the provided token, please check it and try again: %w", err)
+		}
+
+		if err := cli.SaveToken(token); err != nil {
+			slog.Error("failed to save token to config", "error", err)
+			return fmt.Errorf("failed to save token: %w", err)
 		}
+		slog.Info("login successful", "profile", profile)
+		presenter.Status("Login successful. Token saved to profile %q.", profile)
+		return nil
+	},
+}
 
-		viper.Set("auth.token", token)
-		err = viper.SafeWriteConfig()
+// loginToken returns the token from --token or VIRAK_TOKEN, --token-file or
+// --token-stdin, and otherwise runs the browser login.
+func loginToken(ctx context.Context) (string, error) {
+	if token := viper.GetString("token"); token != "" {
+		return token, nil
+	}
+	if loginOpt.TokenFile != "" {
+		f, err := os.Open(loginOpt.TokenFile)
 		if err != nil {
-			err = viper.WriteConfig()
+			return "", fmt.Errorf("failed to read token file: %w", err)
 		}
+		defer f.Close()
+		token, err := cli.ReadToken(f)
 		if err != nil {
-			slog.Error("failed to save token to config", "error", err)
-			fmt.Println("Failed to save token to config:", err)
+			return "", fmt.Errorf("failed to read token file %s: %w", loginOpt.TokenFile, err)
+		}
+		return token, nil
+	}
+	if loginOpt.TokenStdin {
+		token, err := cli.ReadToken(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		return token, nil
+	}
+	return browserLogin(ctx)
+}
+
+// browserLogin sends the user to the panel to create a token and waits for it
+// to arrive on the loopback listener or to be pasted on stdin.
+func browserLogin(ctx context.Context) (string, error) {
+	machineID, err := machineid.ID()
+	if err != nil {
+		slog.Warn("failed to get machine-id", "error", err)
+		machineID = "unknown-machine-id"
+	}
+	loginURL := fmt.Sprintf(urls.LoginUrl, machineID)
+
+	// Over SSH the listener only helps with port forwarding, pasting still works
+	var received <-chan string
+	callback, err := cli.StartLoginCallback()
+	if err != nil {
+		slog.Warn("login callback unavailable", "error", err)
+	} else {
+		defer callback.Close()
+		loginURL = callback.AuthorizeURL(loginURL)
+		received = callback.Tokens()
+	}
+
+	opened := false
+	if !loginOpt.NoBrowser && cli.BrowserAvailable() {
+		if err := browser.OpenURL(loginURL); err != nil {
+			slog.Warn("failed to open browser", "error", err)
+		} else {
+			opened = true
+		}
+	}
+	if opened {
+		fmt.Println("Your browser has been opened to log in to Virak Cloud. If it did not open, visit:")
+	} else {
+		fmt.Println("Open this URL in a browser to log in to Virak Cloud and create a token:")
+	}
+	fmt.Printf("\n  %s\n\n", loginURL)
+
+	interactive := term.IsTerminal(int(os.Stdin.Fd()))
+	if interactive {
+		fmt.Print("Waiting for the browser to finish, or paste the token here and press Enter: ")
+	} else {
+		fmt.Println("Waiting for the browser to finish...")
+	}
+	pasted := make(chan string, 1)
+	stdinClosed := make(chan struct{})
+	go func() {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if token := strings.TrimSpace(line); token != "" {
+			pasted <- token
 			return
 		}
-		fmt.Println("Login successful. Token saved to config.")
-	},
+		slog.Debug("no token on stdin", "error", err)
+		close(stdinClosed)
+	}()
+	// Without a listener, an empty stdin means the token can never arrive
+	var gaveUp <-chan struct{}
+	if received == nil {
+		gaveUp = stdinClosed
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, loginOpt.Timeout)
+	defer cancel()
+	select {
+	case token := <-received:
+		if interactive {
+			fmt.Println()
+		}
+		fmt.Println("Token received from the browser.")
+		return token, nil
+	case token := <-pasted:
+		return token, nil
+	case <-gaveUp:
+		return "", errors.New("no token was entered; use --token-stdin or --token-file to log in without a browser")
+	case <-waitCtx.Done():
+		if interactive {
+			fmt.Println()
+		}
+		if !errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			return "", waitCtx.Err()
+		}
+		if !interactive {
+			return "", fmt.Errorf("timed out after %s waiting for the login to complete; use --token-stdin or --token-file to log in without a browser", loginOpt.Timeout)
+		}
+	}
+
+	// The panel may not hand tokens back to the CLI, so ask for the token
+	// created there instead
+	slog.Warn("no login callback received", "timeout", loginOpt.Timeout)
+	fmt.Printf("No token arrived from the browser within %s. Paste the token created in the panel and press Enter: ", loginOpt.Timeout)
+	select {
+	case token := <-pasted:
+		return token, nil
+	case <-stdinClosed:
+		return "", errors.New("no token was entered; use --token-stdin or --token-file to log in without a browser")
+	case <-ctx.Done():
+		fmt.Println()
+		return "", ctx.Err()
+	}
 }
 
 func init() {
 	RootCmd.AddCommand(loginCmd)
+	_ = cli.BindFlagsFromStruct(loginCmd, &loginOpt)
+	loginCmd.MarkFlagsMutuallyExclusive("token-file", "token-stdin")
 
-	loginCmd.PersistentFlags().String("token", "", "if you have a token, you can use it to login without opening the browser")
-
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// loginCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	// 'virak-cli auth' was an alias of login before auth became a command
+	// group. Without a subcommand it still logs in, with the login flags
+	// hidden from the group's help.
+	loginCmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		hidden := *flag
+		hidden.Hidden = true
+		auth.AuthCmd.Flags().AddFlag(&hidden)
+	})
+	auth.AuthCmd.MarkFlagsMutuallyExclusive("token-file", "token-stdin")
+	auth.AuthCmd.Args = cobra.NoArgs
+	auth.AuthCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(os.Stderr, "Logging in with 'virak-cli auth' is deprecated; use 'virak-cli login'.")
+		return loginCmd.RunE(cmd, args)
+	}
 }
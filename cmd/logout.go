@@ -1,31 +1,127 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/logger"
+	"github.com/virak-cloud/cli/internal/presenter"
+	"github.com/virak-cloud/cli/pkg/http"
 )
 
+type logoutOptions struct {
+	AllProfiles bool `flag:"all-profiles" usage:"Log out of every profile"`
+	Revoke      bool `flag:"revoke" usage:"Also revoke the token on the server (the API does not document this endpoint yet)"`
+}
+
+var logoutOpt logoutOptions
+
 // logoutCmd represents the logout command
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from the Virak Cloud API",
-	Long:  `Logout command removes your authentication token from the Virak Cloud CLI config.`,
+	Long: `Logout command removes the token of the active profile from the credential
+store and deletes the profile's logs and cached data. The token stays valid on
+the server; delete the CLI's web service in the Virak panel to invalidate it.
+
+--revoke also asks the server to revoke the token first. The published API
+reference does not list a revoke endpoint yet, so APIs without it are reported
+rather than treated as a failure. When revoking fails for another reason the
+token is kept so the logout can be retried; without --revoke it is removed
+locally anyway.`,
+	Example: `  virak-cli logout
+  virak-cli logout --revoke
+  virak-cli logout --profile staging
+  virak-cli logout --all-profiles --yes
+  virak-cli logout --all-profiles --dry-run`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		viper.Set("auth.token", "")
-		err := viper.SafeWriteConfig()
-		if err != nil {
-			slog.Error("failed to clear token", "error", err)
-			return fmt.Errorf("failed to clear token: %w", err)
+		if err := cli.LoadFromCobraFlags(cmd, &logoutOpt); err != nil {
+			return err
+		}
+
+		profiles := []string{cli.ActiveProfile()}
+		if logoutOpt.AllProfiles {
+			profiles = cli.Profiles()
+			targets := make([]cli.Target, len(profiles))
+			for i, profile := range profiles {
+				targets[i] = cli.Target{Kind: "profile", Name: profile}
+			}
+			if ok, err := cli.Confirm(cmd.Context(), "log out of", targets...); !ok {
+				return err
+			}
+		} else if !cli.ProfileExists(profiles[0]) {
+			return fmt.Errorf("profile %q does not exist", profiles[0])
+		}
+
+		var failed []string
+		for _, profile := range profiles {
+			if err := logoutProfile(cmd.Context(), profile); err != nil {
+				slog.Error("logout failed", "profile", profile, "error", err)
+				fmt.Fprintf(os.Stderr, "Failed to log out of profile %q: %v\n", profile, err)
+				failed = append(failed, profile)
+			}
+		}
+		if len(failed) > 0 {
+			err := fmt.Errorf("failed to log out of %d of %d profiles: %s", len(failed), len(profiles), strings.Join(failed, ", "))
+			if len(failed) < len(profiles) {
+				return cli.PartialFailure(err)
+			}
+			return err
 		}
-		slog.Info("logout successful")
-		fmt.Println("You have been logged out.")
 		return nil
 	},
 }
 
+// logoutProfile revokes the token of profile with --revoke, then removes it
+// and the profile's local data. With --dry-run the revoke request is printed
+// and nothing is removed.
+func logoutProfile(ctx context.Context, profile string) error {
+	if logoutOpt.Revoke {
+		result, err := cli.RevokeToken(ctx, profile)
+		if err != nil && !errors.Is(err, http.ErrDryRun) {
+			return fmt.Errorf("failed to revoke the token, it was kept so the logout can be retried (without --revoke it is removed anyway): %w", err)
+		}
+		switch result {
+		case cli.Revoked:
+			presenter.Status("Token of profile %q revoked.", profile)
+		case cli.RevokeInvalid:
+			presenter.Status("Token of profile %q was no longer valid.", profile)
+		case cli.RevokeUnsupported:
+			presenter.Status("The API cannot revoke the token of profile %q; delete it in the Virak panel under Web Services.", profile)
+		}
+	}
+
+	// The credentials, logs and cache are local, there is no request to print
+	if cli.DryRun() {
+		presenter.Status("Would remove the token, logs and cached data of profile %q.", profile)
+		return nil
+	}
+	if err := cli.DeleteToken(profile); err != nil {
+		return fmt.Errorf("failed to clear token: %w", err)
+	}
+	slog.Info("logout successful", "profile", profile)
+	// The log file of the active profile is open and about to be removed
+	if profile == cli.ActiveProfile() {
+		if err := logger.CloseLogFile(); err != nil {
+			slog.Warn("failed to close log file", "error", err)
+		}
+	}
+	if err := cli.PurgeProfileData(profile); err != nil {
+		return err
+	}
+	presenter.Status("You have been logged out of profile %q.", profile)
+	return nil
+}
+
 func init() {
 	RootCmd.AddCommand(logoutCmd)
+	_ = cli.BindFlagsFromStruct(logoutCmd, &logoutOpt)
 }
@@ -3,17 +3,21 @@ package create
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type createL2NetworkOptions struct {
-	ZoneID            string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	NetworkOfferingID string `flag:"network-offering-id" usage:"Network offering ID"`
-	Name              string `flag:"name" usage:"Network name"`
+	ZoneID            string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	NetworkOfferingID string        `flag:"network-offering-id" usage:"Network offering ID"`
+	Name              string        `flag:"name" usage:"Network name"`
+	Wait              bool          `flag:"wait" usage:"Wait until the network is provisioned"`
+	WaitTimeout       time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var l2NetworkOptions createL2NetworkOptions
@@ -32,7 +36,7 @@ var networkCreateL2Cmd = &cobra.Command{
 			cli.Required("name"),
 		)
 	},
-		RunE: func(cmd *cobra.Command, args []string) error {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
@@ -40,10 +44,10 @@ var networkCreateL2Cmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+
 		// Validate that the network offering is of type L2
-		serviceOfferings, err := httpClient.GetL2NetworkServiceOfferings(zoneID)
+		serviceOfferings, err := httpClient.GetL2NetworkServiceOfferings(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get L2 network service offerings", "error", err)
 			return fmt.Errorf("error: %w", err)
@@ -54,7 +58,7 @@ var networkCreateL2Cmd = &cobra.Command{
 		for _, offering := range serviceOfferings.Data {
 			if offering.ID == l2NetworkOptions.NetworkOfferingID {
 				if offering.Type != "L2" {
-					return fmt.Errorf("network offering '%s' is not of type L2 (found type: %s)", l2NetworkOptions.NetworkOfferingID, offering.Type)
+					return cli.UsageError(fmt.Errorf("network offering '%s' is not of type L2 (found type: %s)", l2NetworkOptions.NetworkOfferingID, offering.Type))
 				}
 				isValidL2Offering = true
 				break
@@ -62,18 +66,36 @@ var networkCreateL2Cmd = &cobra.Command{
 		}
 
 		if !isValidL2Offering {
-			return fmt.Errorf("network offering ID '%s' not found or is not a valid L2 network offering", l2NetworkOptions.NetworkOfferingID)
+			return cli.UsageError(fmt.Errorf("network offering ID '%s' not found or is not a valid L2 network offering", l2NetworkOptions.NetworkOfferingID))
+		}
+
+		// The create request does not return the new ID, so the wait tells the
+		// new network from older ones with the same name by the IDs listed now
+		var poll http.PollFunc
+		if l2NetworkOptions.Wait {
+			var err error
+			if poll, err = httpClient.PollNewNetwork(cmd.Context(), zoneID, l2NetworkOptions.Name); err != nil {
+				return fmt.Errorf("failed to list networks: %w", err)
+			}
 		}
 
 		// Call the HTTP method and handle response
-		_, err = httpClient.CreateL2Network(zoneID, l2NetworkOptions.NetworkOfferingID, l2NetworkOptions.Name)
+		resp, err := httpClient.CreateL2Network(cmd.Context(), zoneID, l2NetworkOptions.NetworkOfferingID, l2NetworkOptions.Name)
 		if err != nil {
 			slog.Error("failed to create L2 network", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("L2 network created successfully")
-		fmt.Println("L2 network created successfully")
+		if err := presenter.Result(resp, "L2 network created successfully"); err != nil {
+			return err
+		}
+		if l2NetworkOptions.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("network %q", l2NetworkOptions.Name), poll, http.WaitOptions{
+				Target:  []string{http.NetworkStatusAllocated, http.NetworkStatusImplemented},
+				Timeout: l2NetworkOptions.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
@@ -81,4 +103,5 @@ var networkCreateL2Cmd = &cobra.Command{
 func init() {
 	_ = cli.BindFlagsFromStruct(networkCreateL2Cmd, &l2NetworkOptions)
 	NetworkCreateCmd.AddCommand(networkCreateL2Cmd)
+	cli.RequireAbilities(networkCreateL2Cmd, "network:write")
 }
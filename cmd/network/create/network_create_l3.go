@@ -3,19 +3,23 @@ package create
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
 )
 
 type createL3NetworkOptions struct {
-	ZoneID            string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	NetworkOfferingID string `flag:"network-offering-id" usage:"Network offering ID"`
-	Name              string `flag:"name" usage:"Network name"`
-	Gateway           string `flag:"gateway" usage:"Gateway IP address"`
-	Netmask           string `flag:"netmask" usage:"Netmask"`
+	ZoneID            string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	NetworkOfferingID string        `flag:"network-offering-id" usage:"Network offering ID"`
+	Name              string        `flag:"name" usage:"Network name"`
+	Gateway           string        `flag:"gateway" usage:"Gateway IP address"`
+	Netmask           string        `flag:"netmask" usage:"Netmask"`
+	Wait              bool          `flag:"wait" usage:"Wait until the network is provisioned"`
+	WaitTimeout       time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var l3NetworkOptions createL3NetworkOptions
@@ -46,10 +50,10 @@ var networkCreateL3Cmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+
 		// Validate that the network offering is of type L3 (Isolated)
-		serviceOfferings, err := httpClient.GetL3NetworkServiceOfferings(zoneID)
+		serviceOfferings, err := httpClient.GetL3NetworkServiceOfferings(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get L3 network service offerings", "error", err)
 			return fmt.Errorf("error: %w", err)
@@ -60,7 +64,7 @@ var networkCreateL3Cmd = &cobra.Command{
 		for _, offering := range serviceOfferings.Data {
 			if offering.ID == l3NetworkOptions.NetworkOfferingID {
 				if offering.Type != "Isolated" {
-					return fmt.Errorf("network offering '%s' is not of type L3/Isolated (found type: %s)", l3NetworkOptions.NetworkOfferingID, offering.Type)
+					return cli.UsageError(fmt.Errorf("network offering '%s' is not of type L3/Isolated (found type: %s)", l3NetworkOptions.NetworkOfferingID, offering.Type))
 				}
 				isValidL3Offering = true
 				break
@@ -68,18 +72,36 @@ var networkCreateL3Cmd = &cobra.Command{
 		}
 
 		if !isValidL3Offering {
-			return fmt.Errorf("network offering ID '%s' not found or is not a valid L3 network offering", l3NetworkOptions.NetworkOfferingID)
+			return cli.UsageError(fmt.Errorf("network offering ID '%s' not found or is not a valid L3 network offering", l3NetworkOptions.NetworkOfferingID))
+		}
+
+		// The create request does not return the new ID, so the wait tells the
+		// new network from older ones with the same name by the IDs listed now
+		var poll http.PollFunc
+		if l3NetworkOptions.Wait {
+			var err error
+			if poll, err = httpClient.PollNewNetwork(cmd.Context(), zoneID, l3NetworkOptions.Name); err != nil {
+				return fmt.Errorf("failed to list networks: %w", err)
+			}
 		}
 
 		// Call the HTTP method and handle response
-		_, err = httpClient.CreateL3Network(zoneID, l3NetworkOptions.NetworkOfferingID, l3NetworkOptions.Name, l3NetworkOptions.Gateway, l3NetworkOptions.Netmask)
+		resp, err := httpClient.CreateL3Network(cmd.Context(), zoneID, l3NetworkOptions.NetworkOfferingID, l3NetworkOptions.Name, l3NetworkOptions.Gateway, l3NetworkOptions.Netmask)
 		if err != nil {
 			slog.Error("failed to create L3 network", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("L3 network created successfully")
-		fmt.Println("L3 network created successfully")
+		if err := presenter.Result(resp, "L3 network created successfully"); err != nil {
+			return err
+		}
+		if l3NetworkOptions.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("network %q", l3NetworkOptions.Name), poll, http.WaitOptions{
+				Target:  []string{http.NetworkStatusAllocated, http.NetworkStatusImplemented},
+				Timeout: l3NetworkOptions.WaitTimeout,
+			})
+		}
 		return nil
 	},
 }
@@ -87,4 +109,5 @@ var networkCreateL3Cmd = &cobra.Command{
 func init() {
 	_ = cli.BindFlagsFromStruct(networkCreateL3Cmd, &l3NetworkOptions)
 	NetworkCreateCmd.AddCommand(networkCreateL3Cmd)
+	cli.RequireAbilities(networkCreateL3Cmd, "network:write")
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 
 	"github.com/spf13/cobra"
 )
@@ -38,9 +39,7 @@ var NetworkFirewallIPv4CreateCmd = &cobra.Command{
 			cli.Required("networkId"),
 			cli.IsUlid("networkId"),
 			cli.Required("trafficType"),
-			cli.OneOf("trafficType", "Ingress", "Egress"),
 			cli.Required("protocolType"),
-			cli.OneOf("protocolType", "TCP", "UDP", "ICMP"),
 			cli.Required("ipSource"),
 			cli.Required("ipDestination"),
 			cli.RequiredIf("publicIpId", func(v cli.Values) bool { return v.GetString("trafficType") == "Ingress" }),
@@ -72,23 +71,24 @@ var NetworkFirewallIPv4CreateCmd = &cobra.Command{
 			body["port_end"] = firewallIPv4CreateOpts.PortEnd
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.CreateIPv4FirewallRule(zoneId, firewallIPv4CreateOpts.NetworkID, body)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.CreateIPv4FirewallRule(cmd.Context(), zoneId, firewallIPv4CreateOpts.NetworkID, body)
 		if err != nil {
 			slog.Error("failed to create IPv4 firewall rule", "error", err)
 			return fmt.Errorf("failed to create IPv4 firewall rule: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("IPv4 firewall rule created successfully.")
-		} else {
-			fmt.Println("Failed to create IPv4 firewall rule.")
+		if !resp.Data.Success {
+			return fmt.Errorf("failed to create IPv4 firewall rule")
 		}
-		return nil
+		return presenter.Result(resp, "IPv4 firewall rule created successfully.")
 	},
 }
 
 func init() {
 	NetworkFirewallIPv4Cmd.AddCommand(NetworkFirewallIPv4CreateCmd)
+	cli.RequireAbilities(NetworkFirewallIPv4CreateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv4CreateCmd, &firewallIPv4CreateOpts)
+	cli.Enum(NetworkFirewallIPv4CreateCmd, "trafficType", "Ingress", "Egress")
+	cli.Enum(NetworkFirewallIPv4CreateCmd, "protocolType", "TCP", "UDP", "ICMP")
 }
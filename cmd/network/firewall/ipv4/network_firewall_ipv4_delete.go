@@ -3,6 +3,7 @@ package ipv4
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -38,23 +39,26 @@ var NetworkFirewallIPv4DeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeleteIPv4FirewallRule(zoneId, firewallIPv4DeleteOpts.NetworkID, firewallIPv4DeleteOpts.RuleID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "IPv4 firewall rule", ID: firewallIPv4DeleteOpts.RuleID}); !ok {
+			return err
+		}
+
+		resp, err := httpClient.DeleteIPv4FirewallRule(cmd.Context(), zoneId, firewallIPv4DeleteOpts.NetworkID, firewallIPv4DeleteOpts.RuleID)
 		if err != nil {
 			slog.Error("failed to delete IPv4 firewall rule", "error", err)
 			return fmt.Errorf("failed to delete IPv4 firewall rule: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("IPv4 firewall rule deleted successfully.")
-		} else {
-			fmt.Println("Failed to delete IPv4 firewall rule.")
+		if !resp.Data.Success {
+			return fmt.Errorf("failed to delete IPv4 firewall rule")
 		}
-		return nil
+		return presenter.Result(resp, "IPv4 firewall rule deleted successfully.")
 	},
 }
 
 func init() {
 	NetworkFirewallIPv4Cmd.AddCommand(NetworkFirewallIPv4DeleteCmd)
+	cli.RequireAbilities(NetworkFirewallIPv4DeleteCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv4DeleteCmd, &firewallIPv4DeleteOpts)
 }
@@ -3,22 +3,33 @@ package ipv4
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type firewallIPv4ListOptions struct {
 	ZoneID    string `flag:"zoneId" desc:"Zone ID (optional if default.zoneId is set in config)"`
 	NetworkID string `flag:"networkId" desc:"Network ID (required)"`
+	presenter.ListOptions
 }
 
 var firewallIPv4ListOpts firewallIPv4ListOptions
 
+var firewallIPv4Columns = []presenter.Column[responses.IPv4FirewallRule]{
+	{Path: "id", Header: "ID"},
+	{Path: "protocol", Header: "Protocol"},
+	{Path: "traffic_type", Header: "TrafficType"},
+	{Path: "ip_source", Header: "Source"},
+	{Path: "ip_destination", Header: "Destination"},
+	{Path: "status", Header: "Status"},
+	{Path: "created_at", Header: "CreatedAt"},
+}
+
 var NetworkFirewallIPv4ListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List IPv4 firewall rules for a network",
@@ -38,31 +49,31 @@ var NetworkFirewallIPv4ListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &firewallIPv4ListOpts); err != nil {
 			return err
 		}
+		if firewallIPv4ListOpts.ListColumns {
+			return presenter.ListColumns(firewallIPv4Columns)
+		}
+		list, err := presenter.NewList(firewallIPv4ListOpts.ListOptions, firewallIPv4Columns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListIPv4FirewallRules(zoneId, firewallIPv4ListOpts.NetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListIPv4FirewallRules(cmd.Context(), zoneId, firewallIPv4ListOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to list IPv4 firewall rules", "error", err)
 			return fmt.Errorf("failed to list IPv4 firewall rules: %w", err)
 		}
 
-		if len(resp.Data) == 0 {
-			fmt.Println("No IPv4 firewall rules found.")
+		if presenter.Empty(len(resp.Data), "No IPv4 firewall rules found.") {
 			return nil
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Protocol", "TrafficType", "Source", "Destination", "Status", "CreatedAt"})
-
-		for _, rule := range resp.Data {
-			table.Append([]string{rule.ID, rule.Protocol, rule.TrafficType, rule.IPSource, rule.IPDestination, rule.Status, fmt.Sprintf("%d", rule.CreatedAt)})
-		}
-		table.Render()
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkFirewallIPv4Cmd.AddCommand(NetworkFirewallIPv4ListCmd)
+	cli.RequireAbilities(NetworkFirewallIPv4ListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv4ListCmd, &firewallIPv4ListOpts)
 }
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -40,8 +41,6 @@ var NetworkFirewallIPv6CreateCmd = &cobra.Command{
 			cli.Required("protocolType"),
 			cli.Required("ipSource"),
 			cli.Required("ipDestination"),
-			cli.OneOf("trafficType", "Ingress", "Egress"),
-			cli.OneOf("protocolType", "TCP", "UDP", "ICMP"),
 		)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,7 +51,7 @@ var NetworkFirewallIPv6CreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		body := map[string]interface{}{
 			"traffic_type":   firewallIPv6CreateOptions.TrafficType,
 			"protocol_type":  firewallIPv6CreateOptions.ProtocolType,
@@ -63,21 +62,22 @@ var NetworkFirewallIPv6CreateCmd = &cobra.Command{
 			"icmp_code":      firewallIPv6CreateOptions.ICMPCode,
 			"icmp_type":      firewallIPv6CreateOptions.ICMPType,
 		}
-		resp, err := httpClient.CreateIPv6FirewallRule(zoneId, firewallIPv6CreateOptions.NetworkID, body)
+		resp, err := httpClient.CreateIPv6FirewallRule(cmd.Context(), zoneId, firewallIPv6CreateOptions.NetworkID, body)
 		if err != nil {
 			slog.Error("failed to create IPv6 firewall rule", "error", err)
 			return fmt.Errorf("failed to create IPv6 firewall rule: %w", err)
 		}
-		if resp.Data.Success {
-			fmt.Println("IPv6 firewall rule created successfully.")
-		} else {
-			fmt.Println("Failed to create IPv6 firewall rule.")
+		if !resp.Data.Success {
+			return fmt.Errorf("failed to create IPv6 firewall rule")
 		}
-		return nil
+		return presenter.Result(resp, "IPv6 firewall rule created successfully.")
 	},
 }
 
 func init() {
 	NetworkFirewallIPv6Cmd.AddCommand(NetworkFirewallIPv6CreateCmd)
+	cli.RequireAbilities(NetworkFirewallIPv6CreateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv6CreateCmd, &firewallIPv6CreateOptions)
+	cli.Enum(NetworkFirewallIPv6CreateCmd, "trafficType", "Ingress", "Egress")
+	cli.Enum(NetworkFirewallIPv6CreateCmd, "protocolType", "TCP", "UDP", "ICMP")
 }
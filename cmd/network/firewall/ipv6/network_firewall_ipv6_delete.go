@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -41,23 +42,26 @@ var NetworkFirewallIPv6DeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeleteIPv6FirewallRule(zoneId, firewallIPv6DeleteOpts.NetworkId, firewallIPv6DeleteOpts.RuleId)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "IPv6 firewall rule", ID: firewallIPv6DeleteOpts.RuleId}); !ok {
+			return err
+		}
+
+		resp, err := httpClient.DeleteIPv6FirewallRule(cmd.Context(), zoneId, firewallIPv6DeleteOpts.NetworkId, firewallIPv6DeleteOpts.RuleId)
 		if err != nil {
 			slog.Error("failed to delete IPv6 firewall rule", "error", err)
 			return fmt.Errorf("failed to delete IPv6 firewall rule: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("IPv6 firewall rule deleted successfully.")
-		} else {
+		if !resp.Data.Success {
 			return fmt.Errorf("failed to delete IPv6 firewall rule")
 		}
-		return nil
+		return presenter.Result(resp, "IPv6 firewall rule deleted successfully.")
 	},
 }
 
 func init() {
 	NetworkFirewallIPv6Cmd.AddCommand(NetworkFirewallIPv6DeleteCmd)
+	cli.RequireAbilities(NetworkFirewallIPv6DeleteCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv6DeleteCmd, &firewallIPv6DeleteOpts)
 }
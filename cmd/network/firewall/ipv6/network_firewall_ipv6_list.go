@@ -3,22 +3,33 @@ package ipv6
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 type firewallIPv6ListOptions struct {
 	ZoneId    string `flag:"zoneId" desc:"Zone ID (optional if default.zoneId is set in config)"`
 	NetworkId string `flag:"networkId" desc:"Network ID (required)"`
+	presenter.ListOptions
 }
 
 var firewallIPv6ListOpts firewallIPv6ListOptions
 
+var firewallIPv6Columns = []presenter.Column[responses.IPv6FirewallRule]{
+	{Path: "id", Header: "ID"},
+	{Path: "protocol", Header: "Protocol"},
+	{Path: "traffic_type", Header: "TrafficType"},
+	{Path: "ip_source", Header: "Source"},
+	{Path: "ip_destination", Header: "Destination"},
+	{Path: "status", Header: "Status"},
+	{Path: "created_at", Header: "CreatedAt"},
+}
+
 var NetworkFirewallIPv6ListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List IPv6 firewall rules for a network",
@@ -37,30 +48,31 @@ var NetworkFirewallIPv6ListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &firewallIPv6ListOpts); err != nil {
 			return err
 		}
+		if firewallIPv6ListOpts.ListColumns {
+			return presenter.ListColumns(firewallIPv6Columns)
+		}
+		list, err := presenter.NewList(firewallIPv6ListOpts.ListOptions, firewallIPv6Columns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListIPv6FirewallRules(zoneId, firewallIPv6ListOpts.NetworkId)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListIPv6FirewallRules(cmd.Context(), zoneId, firewallIPv6ListOpts.NetworkId)
 		if err != nil {
 			slog.Error("failed to list IPv6 firewall rules", "error", err)
 			return fmt.Errorf("failed to list IPv6 firewall rules: %w", err)
 		}
 
-		if len(resp.Data) == 0 {
-			fmt.Println("No IPv6 firewall rules found.")
+		if presenter.Empty(len(resp.Data), "No IPv6 firewall rules found.") {
 			return nil
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Protocol", "TrafficType", "Source", "Destination", "Status", "CreatedAt"})
-		for _, rule := range resp.Data {
-			table.Append([]string{rule.ID, rule.Protocol, rule.TrafficType, rule.IPSource, rule.IPDestination, rule.Status, fmt.Sprintf("%d", rule.CreatedAt)})
-		}
-		table.Render()
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkFirewallIPv6Cmd.AddCommand(NetworkFirewallIPv6ListCmd)
+	cli.RequireAbilities(NetworkFirewallIPv6ListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkFirewallIPv6ListCmd, &firewallIPv6ListOpts)
 }
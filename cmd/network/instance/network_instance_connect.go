@@ -3,6 +3,7 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -37,24 +38,23 @@ var NetworkInstanceConnectCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &networkInstanceConnectOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ConnectInstanceToNetwork(zoneID, networkInstanceConnectOpt.NetworkID, networkInstanceConnectOpt.InstanceID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ConnectInstanceToNetwork(cmd.Context(), zoneID, networkInstanceConnectOpt.NetworkID, networkInstanceConnectOpt.InstanceID)
 		if err != nil {
 			slog.Error("failed to connect instance to network", "error", err)
 			return fmt.Errorf("failed to connect instance: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("instance connected to network successfully")
-			fmt.Println("Instance successfully connected to network.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("connect instance to network unsuccessful", "response", resp)
 			return fmt.Errorf("failed to connect instance to network")
 		}
-		return nil
+		slog.Info("instance connected to network successfully")
+		return presenter.Result(resp, "Instance successfully connected to network.")
 	},
 }
 
 func init() {
 	NetworkInstanceCmd.AddCommand(NetworkInstanceConnectCmd)
+	cli.RequireAbilities(NetworkInstanceConnectCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkInstanceConnectCmd, &networkInstanceConnectOpt)
 }
@@ -3,6 +3,7 @@ package instance
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"strings"
@@ -40,8 +41,8 @@ var NetworkInstanceDisconnectCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &networkInstanceDisConnectOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DisconnectInstanceFromNetwork(zoneID, networkInstanceDisConnectOpt.NetworkID, networkInstanceDisConnectOpt.InstanceID, networkInstanceDisConnectOpt.InstanceNetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DisconnectInstanceFromNetwork(cmd.Context(), zoneID, networkInstanceDisConnectOpt.NetworkID, networkInstanceDisConnectOpt.InstanceID, networkInstanceDisConnectOpt.InstanceNetworkID)
 		if err != nil {
 			if strings.Contains(err.Error(), "instance_network_id you dont have access") {
 				slog.Error("invalid instance_network_id or no access", "error", err)
@@ -50,18 +51,17 @@ var NetworkInstanceDisconnectCmd = &cobra.Command{
 			slog.Error("failed to disconnect instance from network", "error", err)
 			return fmt.Errorf("failed to disconnect instance: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("instance disconnected from network successfully")
-			fmt.Println("Instance successfully disconnected from network.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("disconnect instance from network unsuccessful", "response", resp)
 			return fmt.Errorf("failed to disconnect instance from network")
 		}
-		return nil
+		slog.Info("instance disconnected from network successfully")
+		return presenter.Result(resp, "Instance successfully disconnected from network.")
 	},
 }
 
 func init() {
 	NetworkInstanceCmd.AddCommand(NetworkInstanceDisconnectCmd)
+	cli.RequireAbilities(NetworkInstanceDisconnectCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkInstanceDisconnectCmd, &networkInstanceDisConnectOpt)
 }
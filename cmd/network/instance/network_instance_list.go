@@ -16,6 +16,7 @@ type networkInstanceListOptions struct {
 	ZoneID     string `flag:"zoneId" usage:"Zone ID (optional if default.zoneId is set in config)"`
 	NetworkID  string `flag:"networkId" usage:"Network ID"`
 	InstanceID string `flag:"instanceId" usage:"Instance ID"`
+	presenter.ListOptions
 }
 
 var networkInstanceListOpt networkInstanceListOptions
@@ -39,22 +40,28 @@ var NetworkInstanceListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &networkInstanceListOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListNetworkInstances(zoneID, networkInstanceListOpt.NetworkID, networkInstanceListOpt.InstanceID)
+		if networkInstanceListOpt.ListColumns {
+			return presenter.ListColumns(presenter.InstanceNetworkColumns)
+		}
+		list, err := presenter.NewList(networkInstanceListOpt.ListOptions, presenter.InstanceNetworkColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListNetworkInstances(cmd.Context(), zoneID, networkInstanceListOpt.NetworkID, networkInstanceListOpt.InstanceID)
 		if err != nil {
 			slog.Error("failed to list instances", "error", err)
 			return fmt.Errorf("failed to list instances: %w", err)
 		}
-		if len(resp.Data) == 0 {
-			fmt.Println("No instances connected to this network.")
+		if presenter.Empty(len(resp.Data), "No instances connected to this network.") {
 			return nil
 		}
-		presenter.RenderInstanceNetworkList(resp.Data)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkInstanceCmd.AddCommand(NetworkInstanceListCmd)
+	cli.RequireAbilities(NetworkInstanceListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkInstanceListCmd, &networkInstanceListOpt)
 }
@@ -3,6 +3,7 @@ package haproxy
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -33,26 +34,26 @@ var NetworkLbHaproxyLiveCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbHaproxyLiveOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetHaproxyLive(zoneId, lbHaproxyLiveOpts.NetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetHaproxyLive(cmd.Context(), zoneId, lbHaproxyLiveOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to get HAProxy live report", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		fmt.Printf("Updated At: %d\n", resp.Data.UpdatedAt)
-		if len(resp.Data.Rules) == 0 {
-			fmt.Println("No HAProxy rules found.")
+		presenter.Status("Updated At: %d", resp.Data.UpdatedAt)
+		if presenter.Empty(len(resp.Data.Rules), "No HAProxy rules found.") {
 			return nil
 		}
-		fmt.Println("ID\tName\tAlgorithm\tPublicPort\tPrivatePort\tStatus")
+		table := presenter.NewTable("ID", "Name", "Algorithm", "PublicPort", "PrivatePort", "Status")
 		for _, rule := range resp.Data.Rules {
-			fmt.Printf("%s\t%s\t%s\t%d\t%d\t%s\n", rule.ID, rule.Name, rule.Algorithm, rule.PublicPort, rule.PrivatePort, rule.Status)
+			table.Append(rule.ID, rule.Name, rule.Algorithm, fmt.Sprintf("%d", rule.PublicPort), fmt.Sprintf("%d", rule.PrivatePort), rule.Status)
 		}
-		return nil
+		return presenter.Output(resp, table)
 	},
 }
 
 func init() {
 	NetworkLbHaproxyCmd.AddCommand(NetworkLbHaproxyLiveCmd)
+	cli.RequireAbilities(NetworkLbHaproxyLiveCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkLbHaproxyLiveCmd, &lbHaproxyLiveOpts)
 }
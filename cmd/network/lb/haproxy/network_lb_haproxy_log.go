@@ -3,6 +3,7 @@ package haproxy
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -33,25 +34,25 @@ var NetworkLbHaproxyLogCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbHaproxyLogOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.GetHaproxyLog(zoneId, lbHaproxyLogOpts.NetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetHaproxyLog(cmd.Context(), zoneId, lbHaproxyLogOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to get HAProxy log report", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if len(resp.Data) == 0 {
-			fmt.Println("No HAProxy logs found.")
+		if presenter.Empty(len(resp.Data), "No HAProxy logs found.") {
 			return nil
 		}
-		fmt.Println("HAProxy Logs:")
+		table := presenter.NewTable("#", "Entry")
 		for i, log := range resp.Data {
-			fmt.Printf("%d: %v\n", i+1, log)
+			table.Append(fmt.Sprintf("%d", i+1), fmt.Sprintf("%v", log))
 		}
-		return nil
+		return presenter.Output(resp, table)
 	},
 }
 
 func init() {
 	NetworkLbHaproxyCmd.AddCommand(NetworkLbHaproxyLogCmd)
+	cli.RequireAbilities(NetworkLbHaproxyLogCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkLbHaproxyLogCmd, &lbHaproxyLogOpts)
 }
@@ -3,6 +3,7 @@ package lb
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 	"strings"
@@ -46,24 +47,23 @@ var NetworkLbAssignCmd = &cobra.Command{
 				return fmt.Errorf("instanceNetworkIds contains empty value")
 			}
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.AssignLoadBalancerRule(zoneID, lbAssignOpts.NetworkID, lbAssignOpts.RuleID, instanceIds)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.AssignLoadBalancerRule(cmd.Context(), zoneID, lbAssignOpts.NetworkID, lbAssignOpts.RuleID, instanceIds)
 		if err != nil {
 			slog.Error("failed to assign instances to load balancer rule", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("instances assigned to load balancer rule successfully")
-			fmt.Println("Instances assigned to load balancer rule successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("assign instances to load balancer rule unsuccessful", "response", resp)
 			return fmt.Errorf("failed to assign instances to load balancer rule")
 		}
-		return nil
+		slog.Info("instances assigned to load balancer rule successfully")
+		return presenter.Result(resp, "Instances assigned to load balancer rule successfully.")
 	},
 }
 
 func init() {
 	NetworkLbCmd.AddCommand(NetworkLbAssignCmd)
+	cli.RequireAbilities(NetworkLbAssignCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkLbAssignCmd, &lbAssignOpts)
 }
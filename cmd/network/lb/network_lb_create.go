@@ -3,6 +3,7 @@ package lb
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -44,8 +45,9 @@ var NetworkLbCreateCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbCreateOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		_, err := httpClient.CreateLoadBalancerRule(
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.CreateLoadBalancerRule(
+			cmd.Context(),
 			zoneID,
 			lbCreateOpts.NetworkID,
 			lbCreateOpts.PublicIPID,
@@ -59,12 +61,12 @@ var NetworkLbCreateCmd = &cobra.Command{
 			return fmt.Errorf("error: %w", err)
 		}
 		slog.Info("load balancer rule created successfully")
-		fmt.Println("Load balancer rule created successfully")
-		return nil
+		return presenter.Result(resp, "Load balancer rule created successfully")
 	},
 }
 
 func init() {
 	NetworkLbCmd.AddCommand(NetworkLbCreateCmd)
+	cli.RequireAbilities(NetworkLbCreateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkLbCreateCmd, &lbCreateOpts)
 }
@@ -3,6 +3,7 @@ package lb
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -38,24 +39,23 @@ var NetworkLbDeassignCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbDeassignOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeassignLoadBalancerRule(zoneID, lbDeassignOpts.NetworkID, lbDeassignOpts.RuleID, lbDeassignOpts.InstanceNetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeassignLoadBalancerRule(cmd.Context(), zoneID, lbDeassignOpts.NetworkID, lbDeassignOpts.RuleID, lbDeassignOpts.InstanceNetworkID)
 		if err != nil {
 			slog.Error("failed to de-assign instance from load balancer rule", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("instance de-assigned from load balancer rule successfully")
-			fmt.Println("Instance de-assigned from load balancer rule successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("failed to de-assign instance from load balancer rule", "response", resp)
 			return fmt.Errorf("de-assign failed")
 		}
-		return nil
+		slog.Info("instance de-assigned from load balancer rule successfully")
+		return presenter.Result(resp, "Instance de-assigned from load balancer rule successfully.")
 	},
 }
 
 func init() {
 	NetworkLbCmd.AddCommand(NetworkLbDeassignCmd)
+	cli.RequireAbilities(NetworkLbDeassignCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkLbDeassignCmd, &lbDeassignOpts)
 }
@@ -3,6 +3,7 @@ package lb
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -36,24 +37,26 @@ var NetworkLbDeleteCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbDeleteOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeleteLoadBalancerRule(zoneID, lbDeleteOpts.NetworkID, lbDeleteOpts.RuleID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "load balancer rule", ID: lbDeleteOpts.RuleID}); !ok {
+			return err
+		}
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteLoadBalancerRule(cmd.Context(), zoneID, lbDeleteOpts.NetworkID, lbDeleteOpts.RuleID)
 		if err != nil {
 			slog.Error("failed to delete load balancer rule", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("load balancer rule deleted successfully")
-			fmt.Println("Load balancer rule deleted successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("load balancer rule deletion unsuccessful", "response", resp)
 			return fmt.Errorf("failed to delete load balancer rule")
 		}
-		return nil
+		slog.Info("load balancer rule deleted successfully")
+		return presenter.Result(resp, "Load balancer rule deleted successfully.")
 	},
 }
 
 func init() {
 	NetworkLbCmd.AddCommand(NetworkLbDeleteCmd)
+	cli.RequireAbilities(NetworkLbDeleteCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkLbDeleteCmd, &lbDeleteOpts)
 }
@@ -3,12 +3,11 @@ package lb
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	http "github.com/virak-cloud/cli/pkg/http"
-
-	"github.com/olekukonko/tablewriter"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 
 	"github.com/spf13/cobra"
 )
@@ -16,10 +15,20 @@ import (
 type lbListOptions struct {
 	ZoneID    string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	NetworkID string `flag:"networkId" usage:"Network ID for the load balancer"`
+	presenter.ListOptions
 }
 
 var lbListOpts lbListOptions
 
+var lbColumns = []presenter.Column[responses.LoadBalancerRule]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "algorithm", Header: "Algorithm"},
+	{Path: "public_port", Header: "PublicPort"},
+	{Path: "private_port", Header: "PrivatePort"},
+	{Path: "status", Header: "Status"},
+}
+
 // NetworkLbListCmd is the command for listing load balancing rules.
 var NetworkLbListCmd = &cobra.Command{
 	Use:   "list",
@@ -38,34 +47,28 @@ var NetworkLbListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &lbListOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListLoadBalancerRules(zoneID, lbListOpts.NetworkID)
+		if lbListOpts.ListColumns {
+			return presenter.ListColumns(lbColumns)
+		}
+		list, err := presenter.NewList(lbListOpts.ListOptions, lbColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListLoadBalancerRules(cmd.Context(), zoneID, lbListOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to list load balancer rules", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if len(resp.Data) == 0 {
-			fmt.Println("No load balancer rules found.")
+		if presenter.Empty(len(resp.Data), "No load balancer rules found.") {
 			return nil
 		}
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Name", "Algorithm", "PublicPort", "PrivatePort", "Status"})
-		for _, rule := range resp.Data {
-			table.Append([]string{
-				rule.ID,
-				rule.Name,
-				rule.Algorithm,
-				fmt.Sprintf("%d", rule.PublicPort),
-				fmt.Sprintf("%d", rule.PrivatePort),
-				rule.Status,
-			})
-		}
-		table.Render()
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkLbCmd.AddCommand(NetworkLbListCmd)
+	cli.RequireAbilities(NetworkLbListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkLbListCmd, &lbListOpts)
 }
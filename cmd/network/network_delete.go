@@ -3,15 +3,19 @@ package network
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
-	ZoneID    string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
-	NetworkID string `flag:"networkId" usage:"Network ID to delete"`
+	ZoneID      string        `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	NetworkID   string        `flag:"networkId" usage:"Network ID to delete"`
+	Wait        bool          `flag:"wait" usage:"Wait until the network is gone"`
+	WaitTimeout time.Duration `flag:"wait-timeout" default:"10m" usage:"Maximum time to wait when --wait is set"`
 }
 
 var deleteOpts deleteOptions
@@ -35,8 +39,12 @@ var networkDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeleteNetwork(zoneID, deleteOpts.NetworkID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Network.Target(deleteOpts.NetworkID)); !ok {
+			return err
+		}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteNetwork(cmd.Context(), zoneID, deleteOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to delete network", "error", err)
 			return fmt.Errorf("error deleting network: %w", err)
@@ -47,7 +55,15 @@ var networkDeleteCmd = &cobra.Command{
 			return fmt.Errorf("network deletion failed")
 		}
 
-		fmt.Println("network deleted successfully")
+		if err := presenter.Result(resp, "network deleted successfully"); err != nil {
+			return err
+		}
+		if deleteOpts.Wait {
+			return cli.Wait(cmd.Context(), fmt.Sprintf("network %s", deleteOpts.NetworkID), httpClient.PollNetwork(zoneID, deleteOpts.NetworkID), http.WaitOptions{
+				UntilGone: true,
+				Timeout:   deleteOpts.WaitTimeout,
+			})
+		}
 		slog.Info("network deleted successfully")
 		return nil
 	},
@@ -56,4 +72,5 @@ var networkDeleteCmd = &cobra.Command{
 func init() {
 	_ = cli.BindFlagsFromStruct(networkDeleteCmd, &deleteOpts)
 	NetworkCmd.AddCommand(networkDeleteCmd)
+	cli.RequireAbilities(networkDeleteCmd, "network:write")
 }
@@ -12,6 +12,7 @@ import (
 
 type listOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
+	presenter.ListOptions
 }
 
 var listOpts listOptions
@@ -31,18 +32,25 @@ var networkListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &listOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListNetworks(zoneId)
+		if listOpts.ListColumns {
+			return presenter.ListColumns(presenter.NetworkColumns)
+		}
+		list, err := presenter.NewList(listOpts.ListOptions, presenter.NetworkColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListNetworks(cmd.Context(), zoneId)
 		if err != nil {
 			slog.Error("failed to list networks", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		presenter.RenderNetworkList(resp.Data)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	_ = cli.BindFlagsFromStruct(networkListCmd, &listOpts)
 	NetworkCmd.AddCommand(networkListCmd)
+	cli.RequireAbilities(networkListCmd, "network:read")
 }
@@ -3,13 +3,12 @@ package network
 import (
 	"fmt"
 	"log/slog"
-	"os"
 	"strings"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
@@ -17,10 +16,25 @@ import (
 type listServiceOfferringOptions struct {
 	ZoneID string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	Type   string `flag:"type" usage:"Filter by service offering type: l2, l3, or all (default: all)"`
+	presenter.ListOptions
 }
 
 var listOfferingOpts listServiceOfferringOptions
 
+var networkOfferingColumns = []presenter.Column[responses.NetworkOffering]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "displayname", Header: "Display Name"},
+	{Path: "hourly_started_price", Header: "Price", Value: func(o responses.NetworkOffering) string { return fmt.Sprintf("%.2f", o.HourlyStartedPrice) }},
+	{Path: "traffic_transfer_overprice", Header: "Overprice", Value: func(o responses.NetworkOffering) string { return fmt.Sprintf("%.2f", o.TrafficTransferOverprice) }},
+	{Path: "traffic_transfer_plan", Header: "Plan(GiB)"},
+	{Path: "networkrate", Header: "Rate(Mbps)"},
+	{Path: "type", Header: "Type"},
+	{Path: "internet_protocol", Header: "Protocol"},
+	{Path: "description", Header: "Desc"},
+	{Path: "displayname_fa", Header: "DisplayNameFA"},
+}
+
 // NetworkServiceOfferingCmd is the command to list network service offerings.
 var NetworkServiceOfferingCmd = &cobra.Command{
 	Use:     "service-offering",
@@ -33,13 +47,19 @@ var NetworkServiceOfferingCmd = &cobra.Command{
 		}
 		return cli.Validate(cmd,
 			cli.Required("type"),
-			cli.OneOf("type", "l2", "l3", "all"),
 		)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := cli.LoadFromCobraFlags(cmd, &listOfferingOpts); err != nil {
 			return err
 		}
+		if listOfferingOpts.ListColumns {
+			return presenter.ListColumns(networkOfferingColumns)
+		}
+		list, err := presenter.NewList(listOfferingOpts.ListOptions, networkOfferingColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 		// Validate type argument
 		if listOfferingOpts.Type != "" {
 			lowerType := strings.ToLower(listOfferingOpts.Type)
@@ -53,68 +73,50 @@ var NetworkServiceOfferingCmd = &cobra.Command{
 		token := cli.TokenFromContext(cmd.Context())
 		zoneID := cli.ZoneIDFromContext(cmd.Context())
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		var resp *responses.NetworkServiceOfferingListResponse
-		var err error
 
 		// Call appropriate HTTP method based on type
 		switch listOfferingOpts.Type {
 		case "l2":
-			resp, err = httpClient.GetL2NetworkServiceOfferings(zoneID)
+			resp, err = httpClient.GetL2NetworkServiceOfferings(cmd.Context(), zoneID)
 			if err != nil {
 				slog.Error("failed to list L2 network service offerings", "error", err)
 				return fmt.Errorf("error: %w", err)
 			}
 		case "l3":
-			resp, err = httpClient.GetL3NetworkServiceOfferings(zoneID)
+			resp, err = httpClient.GetL3NetworkServiceOfferings(cmd.Context(), zoneID)
 			if err != nil {
 				slog.Error("failed to list L3 network service offerings", "error", err)
 				return fmt.Errorf("error: %w", err)
 			}
 		default: // "all"
-			resp, err = httpClient.ListNetworkServiceOfferings(zoneID)
+			resp, err = httpClient.ListNetworkServiceOfferings(cmd.Context(), zoneID)
 			if err != nil {
 				slog.Error("failed to list network service offerings", "error", err)
 				return fmt.Errorf("error: %w", err)
 			}
 		}
 
-		if len(resp.Data) == 0 {
+		if len(resp.Data) == 0 && presenter.Tabular() {
 			switch listOfferingOpts.Type {
 			case "l2":
-				fmt.Println("No L2 network service offerings found.")
+				presenter.Status("No L2 network service offerings found.")
 			case "l3":
-				fmt.Println("No L3 network service offerings found.")
+				presenter.Status("No L3 network service offerings found.")
 			default:
-				fmt.Println("No network service offerings found.")
+				presenter.Status("No network service offerings found.")
 			}
 			return nil
 		}
 
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "Name", "Display Name", "Price", "Overprice", "Plan(GiB)", "Rate(Mbps)", "Type", "Protocol", "Desc", "DisplayNameFA"})
-		for _, offering := range resp.Data {
-			row := []string{
-				offering.ID,
-				offering.Name,
-				offering.DisplayName,
-				fmt.Sprintf("%.2f", offering.HourlyStartedPrice),
-				fmt.Sprintf("%.2f", offering.TrafficTransferOverprice),
-				fmt.Sprintf("%d", offering.TrafficTransferPlan),
-				fmt.Sprintf("%d", offering.NetworkRate),
-				offering.Type,
-				offering.InternetProtocol,
-				offering.Description,
-				offering.DisplayNameFA,
-			}
-			table.Append(row)
-		}
-		table.Render()
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	_ = cli.BindFlagsFromStruct(NetworkServiceOfferingCmd, &listOfferingOpts)
+	cli.Enum(NetworkServiceOfferingCmd, "type", "l2", "l3", "all")
 	NetworkCmd.AddCommand(NetworkServiceOfferingCmd)
+	cli.RequireAbilities(NetworkServiceOfferingCmd, "network:read")
 }
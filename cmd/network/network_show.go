@@ -34,18 +34,18 @@ var networkShowCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &showOpts); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ShowNetwork(zoneID, showOpts.NetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ShowNetwork(cmd.Context(), zoneID, showOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to show network", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		presenter.RenderNetworkDetail(resp.Data)
-		return nil
+		return presenter.RenderNetworkDetail(resp)
 	},
 }
 
 func init() {
 	_ = cli.BindFlagsFromStruct(networkShowCmd, &showOpts)
 	NetworkCmd.AddCommand(networkShowCmd)
+	cli.RequireAbilities(networkShowCmd, "network:read")
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 
 	"github.com/spf13/cobra"
 )
@@ -33,7 +34,6 @@ var NetworkPortForwardCreateCmd = &cobra.Command{
 			cli.Required("networkId"),
 			cli.IsUlid("networkId"),
 			cli.Required("protocol"),
-			cli.OneOf("protocol", "TCP", "UDP"),
 			cli.Required("publicPort"),
 			cli.Required("privatePort"),
 			cli.Required("privateIp"),
@@ -55,23 +55,23 @@ var NetworkPortForwardCreateCmd = &cobra.Command{
 			"private_ip":   portForwardCreateOpts.PrivateIP,
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.CreatePortForward(zoneId, request)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.CreatePortForward(cmd.Context(), zoneId, request)
 		if err != nil {
 			slog.Error("failed to create port forwarding rule", "error", err)
 			return fmt.Errorf("failed to create port forwarding rule: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("Port forwarding rule created successfully.")
-		} else {
-			fmt.Println("Failed to create port forwarding rule.")
+		if !resp.Data.Success {
+			return fmt.Errorf("failed to create port forwarding rule")
 		}
-		return nil
+		return presenter.Result(resp, "Port forwarding rule created successfully.")
 	},
 }
 
 func init() {
 	NetworkPortForwardCmd.AddCommand(NetworkPortForwardCreateCmd)
+	cli.RequireAbilities(NetworkPortForwardCreateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPortForwardCreateCmd, &portForwardCreateOpts)
+	cli.Enum(NetworkPortForwardCreateCmd, "protocol", "TCP", "UDP")
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -37,23 +38,26 @@ var NetworkPortForwardDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.DeletePortForward(zoneId, portForwardDeleteOpts.ID)
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "port forwarding rule", ID: portForwardDeleteOpts.ID}); !ok {
+			return err
+		}
+
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeletePortForward(cmd.Context(), zoneId, portForwardDeleteOpts.ID)
 		if err != nil {
 			slog.Error("failed to delete port forwarding rule", "error", err)
 			return fmt.Errorf("failed to delete port forwarding rule: %w", err)
 		}
 
-		if resp.Data.Success {
-			fmt.Println("Port forwarding rule deleted successfully.")
-		} else {
-			fmt.Println("Failed to delete port forwarding rule.")
+		if !resp.Data.Success {
+			return fmt.Errorf("failed to delete port forwarding rule")
 		}
-		return nil
+		return presenter.Result(resp, "Port forwarding rule deleted successfully.")
 	},
 }
 
 func init() {
 	NetworkPortForwardCmd.AddCommand(NetworkPortForwardDeleteCmd)
+	cli.RequireAbilities(NetworkPortForwardDeleteCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPortForwardDeleteCmd, &portForwardDeleteOpts)
 }
\ No newline at end of file
@@ -14,6 +14,7 @@ import (
 type portForwardListOptions struct {
 	ZoneID    string `flag:"zoneId" desc:"Zone ID (optional if default.zoneId is set in config)"`
 	NetworkID string `flag:"networkId" desc:"Network ID (required)"`
+	presenter.ListOptions
 }
 
 var portForwardListOpts portForwardListOptions
@@ -37,25 +38,31 @@ var NetworkPortForwardListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &portForwardListOpts); err != nil {
 			return err
 		}
+		if portForwardListOpts.ListColumns {
+			return presenter.ListColumns(presenter.PortForwardColumns)
+		}
+		list, err := presenter.NewList(portForwardListOpts.ListOptions, presenter.PortForwardColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListPortForwards(zoneId, portForwardListOpts.NetworkID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListPortForwards(cmd.Context(), zoneId, portForwardListOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to list port forwarding rules", "error", err)
 			return fmt.Errorf("failed to list port forwarding rules: %w", err)
 		}
 
-		if len(resp.Data) == 0 {
-			fmt.Println("No port forwarding rules found.")
+		if presenter.Empty(len(resp.Data), "No port forwarding rules found.") {
 			return nil
 		}
 
-		presenter.RenderPortForwardList(resp.Data)
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkPortForwardCmd.AddCommand(NetworkPortForwardListCmd)
+	cli.RequireAbilities(NetworkPortForwardListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkPortForwardListCmd, &portForwardListOpts)
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -37,24 +38,23 @@ var NetworkPublicIPAssociateCmd = &cobra.Command{
 			return err
 		}
 
-		client := http.NewClient(token)
-		resp, err := client.AssociateNetworkPublicIp(zoneID, associateOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.AssociateNetworkPublicIp(cmd.Context(), zoneID, associateOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to associate public IP", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("Public IP association started successfully.")
-			fmt.Println("Public IP association started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("public IP association unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start public IP association")
 		}
-		return nil
+		slog.Info("Public IP association started successfully.")
+		return presenter.Result(resp, "Public IP association started successfully.")
 	},
 }
 
 func init() {
 	NetworkPublicIPCmd.AddCommand(NetworkPublicIPAssociateCmd)
+	cli.RequireAbilities(NetworkPublicIPAssociateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPublicIPAssociateCmd, &associateOpts)
 }
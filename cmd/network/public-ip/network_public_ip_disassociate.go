@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -39,24 +40,27 @@ var NetworkPublicIPDisassociateCmd = &cobra.Command{
 			return err
 		}
 
-		client := http.NewClient(token)
-		resp, err := client.DisassociateNetworkPublicIp(zoneID, disassociateOpts.NetworkID, disassociateOpts.NetworkPublicIPID)
+		if ok, err := cli.Confirm(cmd.Context(), "release", cli.Target{Kind: "public IP", ID: disassociateOpts.NetworkPublicIPID}); !ok {
+			return err
+		}
+
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.DisassociateNetworkPublicIp(cmd.Context(), zoneID, disassociateOpts.NetworkID, disassociateOpts.NetworkPublicIPID)
 		if err != nil {
 			slog.Error("failed to disassociate public IP", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("Public IP disassociation started successfully.")
-			fmt.Println("Public IP disassociation started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("public IP disassociation unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start public IP disassociation")
 		}
-		return nil
+		slog.Info("Public IP disassociation started successfully.")
+		return presenter.Result(resp, "Public IP disassociation started successfully.")
 	},
 }
 
 func init() {
 	NetworkPublicIPCmd.AddCommand(NetworkPublicIPDisassociateCmd)
+	cli.RequireAbilities(NetworkPublicIPDisassociateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPublicIPDisassociateCmd, &disassociateOpts)
 }
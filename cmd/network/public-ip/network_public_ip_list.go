@@ -3,22 +3,31 @@ package publicip
 import (
 	"fmt"
 	"log/slog"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type listOptions struct {
 	ZoneID    string `flag:"zoneId" usage:"Zone ID to use (optional if default.zoneId is set in config)"`
 	NetworkID string `flag:"networkId" usage:"Network ID to associate the public IP with"`
+	presenter.ListOptions
 }
 
 var listOpts listOptions
 
+var publicIPColumns = []presenter.Column[responses.NetworkPublicIp]{
+	{Path: "id", Header: "ID"},
+	{Path: "ipaddress", Header: "IP Address"},
+	{Path: "is_sourcenat", Header: "Is Source NAT"},
+	{Path: "staticnat_enable", Header: "Static NAT Enabled"},
+	{Path: "created_at", Header: "Created At"},
+}
+
 // NetworkPublicIPListCmd represents the list subcommand
 var NetworkPublicIPListCmd = &cobra.Command{
 	Use:   "list",
@@ -37,34 +46,29 @@ var NetworkPublicIPListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &listOpts); err != nil {
 			return err
 		}
+		if listOpts.ListColumns {
+			return presenter.ListColumns(publicIPColumns)
+		}
+		list, err := presenter.NewList(listOpts.ListOptions, publicIPColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		client := http.NewClient(token)
-		resp, err := client.ListNetworkPublicIps(zoneID, listOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.ListNetworkPublicIps(cmd.Context(), zoneID, listOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to list public IPs", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if len(resp.Data) == 0 {
-			fmt.Println("No public IPs found for this network.")
+		if presenter.Empty(len(resp.Data), "No public IPs found for this network.") {
 			return nil
 		}
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"ID", "IP Address", "Is Source NAT", "Static NAT Enabled", "Created At"})
-		for _, ip := range resp.Data {
-			table.Append([]string{
-				ip.ID,
-				ip.IpAddress,
-				fmt.Sprintf("%v", ip.IsSourceNat),
-				fmt.Sprintf("%v", ip.StaticNatEnable),
-				fmt.Sprintf("%v", ip.CreatedAt),
-			})
-		}
-		table.Render()
-		return nil
+		return list.Output(resp, resp.Data)
 	},
 }
 
 func init() {
 	NetworkPublicIPCmd.AddCommand(NetworkPublicIPListCmd)
+	cli.RequireAbilities(NetworkPublicIPListCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkPublicIPListCmd, &listOpts)
 }
@@ -3,6 +3,7 @@ package staticnat
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -41,26 +42,25 @@ var NetworkPublicIPStaticNatDisableCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		// Call the HTTP method and handle response
-		resp, err := httpClient.DisableNetworkPublicIpStaticNat(zoneID, disableOpts.NetworkID, disableOpts.NetworkPublicIPID)
+		resp, err := httpClient.DisableNetworkPublicIpStaticNat(cmd.Context(), zoneID, disableOpts.NetworkID, disableOpts.NetworkPublicIPID)
 		if err != nil {
 			slog.Error("failed to disable static NAT", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		if resp.Data.Success {
-			slog.Info("static NAT disable operation completed successfully")
-			fmt.Println("Static NAT disable started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("static NAT disable unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start static NAT disable")
 		}
-		return nil
+		slog.Info("static NAT disable operation completed successfully")
+		return presenter.Result(resp, "Static NAT disable started successfully.")
 	},
 }
 
 func init() {
 	NetworkPublicIPStaticNatCmd.AddCommand(NetworkPublicIPStaticNatDisableCmd)
+	cli.RequireAbilities(NetworkPublicIPStaticNatDisableCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPublicIPStaticNatDisableCmd, &disableOpts)
 }
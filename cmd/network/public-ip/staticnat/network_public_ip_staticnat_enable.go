@@ -3,6 +3,7 @@ package staticnat
 import (
 	"fmt"
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 	"log/slog"
 
@@ -43,26 +44,25 @@ var NetworkPublicIPStaticNatEnableCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := http.NewClient(token)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
 		// Call the HTTP method and handle response
-		resp, err := httpClient.EnableNetworkPublicIpStaticNat(zoneID, enableOpts.NetworkID, enableOpts.NetworkPublicIPID, enableOpts.InstanceID)
+		resp, err := httpClient.EnableNetworkPublicIpStaticNat(cmd.Context(), zoneID, enableOpts.NetworkID, enableOpts.NetworkPublicIPID, enableOpts.InstanceID)
 		if err != nil {
 			slog.Error("failed to enable static NAT", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
-		if resp.Data.Success {
-			slog.Info("static NAT enable operation completed successfully")
-			fmt.Println("Static NAT enable started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("static NAT enable unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start static NAT enable")
 		}
-		return nil
+		slog.Info("static NAT enable operation completed successfully")
+		return presenter.Result(resp, "Static NAT enable started successfully.")
 	},
 }
 
 func init() {
 	NetworkPublicIPStaticNatCmd.AddCommand(NetworkPublicIPStaticNatEnableCmd)
+	cli.RequireAbilities(NetworkPublicIPStaticNatEnableCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkPublicIPStaticNatEnableCmd, &enableOpts)
 }
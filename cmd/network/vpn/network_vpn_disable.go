@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -35,8 +36,8 @@ var NetworkVpnDisableCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &vpnDisableOpts); err != nil {
 			return err
 		}
-		client := http.NewClient(token)
-		resp, err := client.DisableNetworkVpn(zoneID, vpnDisableOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.DisableNetworkVpn(cmd.Context(), zoneID, vpnDisableOpts.NetworkID)
 		if err != nil {
 			if strings.Contains(err.Error(), "The network should be Implemented") {
 				slog.Error("network not implemented, need to connect instance", "error", err)
@@ -46,18 +47,17 @@ var NetworkVpnDisableCmd = &cobra.Command{
 			return fmt.Errorf("error: %w", err)
 		}
 
-		if resp.Data.Success {
-			slog.Info("VPN disable started successfully")
-			fmt.Println("VPN disable started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("VPN disable unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start VPN disable")
 		}
-		return nil
+		slog.Info("VPN disable started successfully")
+		return presenter.Result(resp, "VPN disable started successfully.")
 	},
 }
 
 func init() {
 	NetworkVpnCmd.AddCommand(NetworkVpnDisableCmd)
+	cli.RequireAbilities(NetworkVpnDisableCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkVpnDisableCmd, &vpnDisableOpts)
 }
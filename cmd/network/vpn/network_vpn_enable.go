@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -35,8 +36,8 @@ var NetworkVpnEnableCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &vpnEnableOpts); err != nil {
 			return err
 		}
-		client := http.NewClient(token)
-		resp, err := client.EnableNetworkVpn(zoneID, vpnEnableOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.EnableNetworkVpn(cmd.Context(), zoneID, vpnEnableOpts.NetworkID)
 		if err != nil {
 			if strings.Contains(err.Error(), "The network should be Implemented") {
 				slog.Error("network not implemented, need to connect instance", "error", err)
@@ -45,18 +46,17 @@ var NetworkVpnEnableCmd = &cobra.Command{
 			slog.Error("failed to enable VPN", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		if resp.Data.Success {
-			slog.Info("VPN enable started successfully")
-			fmt.Println("VPN enable started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("VPN enable unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start VPN enable")
 		}
-		return nil
+		slog.Info("VPN enable started successfully")
+		return presenter.Result(resp, "VPN enable started successfully.")
 	},
 }
 
 func init() {
 	NetworkVpnCmd.AddCommand(NetworkVpnEnableCmd)
+	cli.RequireAbilities(NetworkVpnEnableCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkVpnEnableCmd, &vpnEnableOpts)
 }
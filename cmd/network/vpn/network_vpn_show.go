@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -34,19 +35,24 @@ var NetworkVpnShowCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &vpnShowOpts); err != nil {
 			return err
 		}
-		client := http.NewClient(token)
-		resp, err := client.GetNetworkVpnDetails(zoneID, vpnShowOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.GetNetworkVpnDetails(cmd.Context(), zoneID, vpnShowOpts.NetworkID)
 		if err != nil {
 			slog.Error("failed to get VPN details", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
-		fmt.Printf("VPN IP Address: %s\nUsername: %s\nPassword: %s\nPreshared Key: %s\nStatus: %s\n",
-			resp.Data.IPAddress, resp.Data.Username, resp.Data.Password, resp.Data.PresharedKey, resp.Data.Status)
-		return nil
+		table := presenter.NewTable("Field", "Value")
+		table.Append("VPN IP Address", resp.Data.IPAddress)
+		table.Append("Username", resp.Data.Username)
+		table.Append("Password", resp.Data.Password)
+		table.Append("Preshared Key", resp.Data.PresharedKey)
+		table.Append("Status", resp.Data.Status)
+		return presenter.Output(resp, table)
 	},
 }
 
 func init() {
 	NetworkVpnCmd.AddCommand(NetworkVpnShowCmd)
+	cli.RequireAbilities(NetworkVpnShowCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(NetworkVpnShowCmd, &vpnShowOpts)
 }
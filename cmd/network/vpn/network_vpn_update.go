@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 
 	"github.com/spf13/cobra"
@@ -34,8 +35,8 @@ var NetworkVpnUpdateCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &vpnUpdateOpts); err != nil {
 			return err
 		}
-		client := http.NewClient(token)
-		resp, err := client.UpdateNetworkVpnCredentials(zoneID, vpnUpdateOpts.NetworkID)
+		client := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := client.UpdateNetworkVpnCredentials(cmd.Context(), zoneID, vpnUpdateOpts.NetworkID)
 		if err != nil {
 			if strings.Contains(err.Error(), "The network should be Implemented") {
 				slog.Error("network not implemented, need to connect instance", "error", err)
@@ -45,18 +46,17 @@ var NetworkVpnUpdateCmd = &cobra.Command{
 			return fmt.Errorf("error: %w", err)
 		}
 
-		if resp.Data.Success {
-			slog.Info("VPN credentials update started successfully")
-			fmt.Println("VPN credentials update started successfully.")
-		} else {
+		if !resp.Data.Success {
 			slog.Error("VPN credentials update unsuccessful", "response", resp)
 			return fmt.Errorf("failed to start VPN credentials update")
 		}
-		return nil
+		slog.Info("VPN credentials update started successfully")
+		return presenter.Result(resp, "VPN credentials update started successfully.")
 	},
 }
 
 func init() {
 	NetworkVpnCmd.AddCommand(NetworkVpnUpdateCmd)
+	cli.RequireAbilities(NetworkVpnUpdateCmd, "network:write")
 	_ = cli.BindFlagsFromStruct(NetworkVpnUpdateCmd, &vpnUpdateOpts)
 }
@@ -0,0 +1,20 @@
+package profile
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// ProfileCmd represents the profile command
+var ProfileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage config profiles",
+	Long: `Profiles keep separate credentials and default zones for different accounts
+or environments, e.g. production, staging and a customer sandbox.
+
+The active profile is chosen by the --profile flag, then the VIRAK_PROFILE
+environment variable, then the profile selected with 'virak-cli profile use'.`,
+}
+
+func init() {
+
+}
@@ -0,0 +1,51 @@
+package profile
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+type profileCreateOptions struct {
+	Use bool `flag:"use" usage:"Make the new profile the active profile"`
+}
+
+var profileCreateOpt profileCreateOptions
+
+var profileCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create an empty profile",
+	Example: `  virak-cli profile create staging
+  virak-cli login --profile staging`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &profileCreateOpt); err != nil {
+			return err
+		}
+
+		name := args[0]
+		if err := cli.CreateProfile(name); err != nil {
+			slog.Error("failed to create profile", "profile", name, "error", err)
+			return err
+		}
+		slog.Info("profile created", "profile", name)
+		presenter.Status("Profile %q created. Log in with 'virak-cli login --profile %s'.", name, name)
+
+		if profileCreateOpt.Use {
+			if err := cli.UseProfile(name); err != nil {
+				slog.Error("failed to switch profile", "profile", name, "error", err)
+				return err
+			}
+			presenter.Status("Switched to profile %q.", name)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileCreateCmd)
+	_ = cli.BindFlagsFromStruct(profileCreateCmd, &profileCreateOpt)
+}
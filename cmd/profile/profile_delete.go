@@ -0,0 +1,40 @@
+package profile
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var profileDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a profile and its saved credentials",
+	Long: `Delete a profile and its saved credentials. The active profile cannot be
+deleted; switch to another profile with 'virak-cli profile use' first.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.Target{Kind: "profile", Name: name}); !ok {
+			return err
+		}
+		// Profiles are local, there is no request to print
+		if cli.DryRun() {
+			presenter.Status("Would delete profile %q.", name)
+			return nil
+		}
+		if err := cli.DeleteProfile(name); err != nil {
+			slog.Error("failed to delete profile", "profile", name, "error", err)
+			return err
+		}
+		slog.Info("profile deleted", "profile", name)
+		presenter.Status("Profile %q deleted.", name)
+		return nil
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileDeleteCmd)
+}
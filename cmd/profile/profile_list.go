@@ -0,0 +1,44 @@
+package profile
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+type profileListOptions struct {
+	presenter.ListOptions
+}
+
+var profileListOpt profileListOptions
+
+var profileListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List config profiles",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &profileListOpt); err != nil {
+			return err
+		}
+		if profileListOpt.ListColumns {
+			return presenter.ListColumns(presenter.ProfileColumns)
+		}
+		list, err := presenter.NewList(profileListOpt.ListOptions, presenter.ProfileColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
+		var profiles []cli.Profile
+		for _, name := range cli.Profiles() {
+			profiles = append(profiles, cli.LoadProfile(name))
+		}
+		return list.Output(profiles, profiles)
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileListCmd)
+	_ = cli.BindFlagsFromStruct(profileListCmd, &profileListOpt)
+}
@@ -0,0 +1,31 @@
+package profile
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var profileRenameCmd = &cobra.Command{
+	Use:     "rename <old-name> <new-name>",
+	Short:   "Rename a profile",
+	Example: `  virak-cli profile rename default production`,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldName, newName := args[0], args[1]
+		if err := cli.RenameProfile(oldName, newName); err != nil {
+			slog.Error("failed to rename profile", "profile", oldName, "newName", newName, "error", err)
+			return err
+		}
+		slog.Info("profile renamed", "profile", oldName, "newName", newName)
+		presenter.Status("Profile %q renamed to %q.", oldName, newName)
+		return nil
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileRenameCmd)
+}
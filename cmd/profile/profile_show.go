@@ -0,0 +1,30 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var profileShowCmd = &cobra.Command{
+	Use:   "show [name]",
+	Short: "Show a profile (the active profile by default)",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := cli.ActiveProfile()
+		if len(args) == 1 {
+			name = args[0]
+		}
+		if !cli.ProfileExists(name) {
+			return fmt.Errorf("profile %q does not exist", name)
+		}
+		return presenter.RenderProfile(cli.LoadProfile(name))
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileShowCmd)
+}
@@ -0,0 +1,31 @@
+package profile
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
+)
+
+var profileUseCmd = &cobra.Command{
+	Use:     "use <name>",
+	Short:   "Make a profile the active profile",
+	Example: `  virak-cli profile use staging`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		if err := cli.UseProfile(name); err != nil {
+			slog.Error("failed to switch profile", "profile", name, "error", err)
+			return err
+		}
+		slog.Info("active profile changed", "profile", name)
+		presenter.Status("Switched to profile %q.", name)
+		return nil
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileUseCmd)
+}
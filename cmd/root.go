@@ -1,51 +1,130 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/virak-cloud/cli/cmd/auth"
 	bucket "github.com/virak-cloud/cli/cmd/bucket"
 	"github.com/virak-cloud/cli/cmd/cluster"
+	"github.com/virak-cloud/cli/cmd/config"
+	"github.com/virak-cloud/cli/cmd/dev"
 	"github.com/virak-cloud/cli/cmd/dns"
 	"github.com/virak-cloud/cli/cmd/finance"
 	"github.com/virak-cloud/cli/cmd/instance"
 	"github.com/virak-cloud/cli/cmd/network"
+	"github.com/virak-cloud/cli/cmd/profile"
 	"github.com/virak-cloud/cli/cmd/user"
 	"github.com/virak-cloud/cli/cmd/zone"
+	"github.com/virak-cloud/cli/internal/cli"
 	"github.com/virak-cloud/cli/internal/logger"
+	"github.com/virak-cloud/cli/internal/presenter"
+	"github.com/virak-cloud/cli/pkg/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var disableLog bool
+var (
+	disableLog bool
+	recordFile string
+	replayFile string
+)
 
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
 	Use:   "virak-cli",
 	Short: "A command-line interface for interacting with the Virak Cloud API, built with the Go programming language.",
 	Long:  `The vk-cloud CLI is a command-line interface that allows you to manage your Virak Cloud resources directly from your terminal.`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	// Execute prints errors, usage only for usage errors
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if format := viper.GetString("output"); format != "" && !slices.Contains(cli.OutputFormats, format) {
+			return cli.UsageError(fmt.Errorf("invalid output format %q; use one of: %s", format, strings.Join(cli.OutputFormats, ", ")))
+		}
+		if query := viper.GetString("query"); query != "" {
+			if _, err := presenter.CompileQuery(query); err != nil {
+				return cli.UsageError(err)
+			}
+		}
+		logDir := ""
 		if !disableLog {
-			logger.InitLogger()
+			dir, err := cli.LogDir(cli.ActiveProfile())
+			if err != nil {
+				return err
+			}
+			logDir = dir
 		}
+		logger.InitLogger(logDir, viper.GetBool("debug") || viper.GetBool("trace"))
+		return cli.OpenCassette(recordFile, replayFile)
 	},
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	//Run: func(cmd *cobra.Command, args []string) {},
 }
 
-// Execute adds all child commands to the root command and sets flags appropriately.
-// This is called by main.main(). It only needs to happen once to the RootCmd.
+// Execute adds all child commands to the root command and sets flags appropriately,
+// including their shell completion. This is called by main.main(). It only needs to happen once to the RootCmd.
+// Interrupt signals cancel the command context so in-flight API calls are aborted.
+// Errors are printed here rather than by cobra, and end the CLI with the exit code of cli.ExitCode.
 func Execute() {
-	err := RootCmd.Execute()
-	if err != nil {
-		os.Exit(1)
+	cli.RegisterCompletions(RootCmd)
+	cli.MarkUsageErrors(RootCmd)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	cmd, err := RootCmd.ExecuteContextC(ctx)
+	stop()
+	if err == nil || errors.Is(err, http.ErrDryRun) {
+		// The request --dry-run did not send ends the command, it is not a failure
+		return
+	}
+	code := cli.ExitCode(err)
+	presenter.Error(err, code)
+	if hint := cli.ForbiddenHint(cmd, err); hint != "" {
+		fmt.Fprintln(os.Stderr, hint)
+	}
+	if code == cli.ExitUsage && cmd != nil && presenter.Tabular() {
+		fmt.Fprintf(os.Stderr, "Run '%s --help' for usage.\n", cmd.CommandPath())
 	}
+	os.Exit(code)
 }
 
 func init() {
 	RootCmd.PersistentFlags().BoolVar(&disableLog, "disable-log", false, "Disable logging")
+	RootCmd.PersistentFlags().Int("retries", 2, "Number of times to retry transient API failures (0 disables retrying)")
+	_ = viper.BindPFlag("retries", RootCmd.PersistentFlags().Lookup("retries"))
+	RootCmd.PersistentFlags().Bool("debug", false, "Log API requests (method, URL, status, latency) to stderr and the log file")
+	RootCmd.PersistentFlags().Bool("trace", false, "Like --debug, but also log request and response bodies (secrets are redacted)")
+	_ = viper.BindPFlag("debug", RootCmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("trace", RootCmd.PersistentFlags().Lookup("trace"))
+	RootCmd.PersistentFlags().String("profile", "", "Config profile to use (overrides VIRAK_PROFILE and the active profile)")
+	_ = viper.BindPFlag("profile", RootCmd.PersistentFlags().Lookup("profile"))
+	_ = viper.BindEnv("profile", "VIRAK_PROFILE")
+	RootCmd.PersistentFlags().String("token", "", "API token to use instead of the profile's (overrides VIRAK_TOKEN)")
+	_ = viper.BindPFlag("token", RootCmd.PersistentFlags().Lookup("token"))
+	_ = viper.BindEnv("token", "VIRAK_TOKEN")
+	RootCmd.PersistentFlags().StringP("output", "o", "", "Output format: table, json, yaml, csv or tsv (overrides the output config key)")
+	_ = viper.BindPFlag("output", RootCmd.PersistentFlags().Lookup("output"))
+	_ = viper.BindEnv("output", "VIRAK_OUTPUT")
+	_ = RootCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(cli.OutputFormats, cobra.ShellCompDirectiveNoFileComp))
+	RootCmd.PersistentFlags().String("query", "", "JMESPath expression to select from the response, e.g. \"data[?status=='UP'].id\"")
+	_ = viper.BindPFlag("query", RootCmd.PersistentFlags().Lookup("query"))
+	RootCmd.PersistentFlags().BoolP("yes", "y", false, "Do not ask for confirmation before destructive actions")
+	_ = viper.BindPFlag("yes", RootCmd.PersistentFlags().Lookup("yes"))
+	RootCmd.PersistentFlags().Bool("dry-run", false, "Print the API requests that would change something instead of sending them")
+	_ = viper.BindPFlag("dryRun", RootCmd.PersistentFlags().Lookup("dry-run"))
+	RootCmd.PersistentFlags().String("api-url", "", "Base URL of the Virak Cloud API (overrides VIRAK_API_URL)")
+	_ = viper.BindPFlag("apiUrl", RootCmd.PersistentFlags().Lookup("api-url"))
+	_ = viper.BindEnv("apiUrl", "VIRAK_API_URL")
+	_ = viper.BindEnv("zoneId", "VIRAK_ZONE_ID")
+	RootCmd.PersistentFlags().StringVar(&recordFile, "record", "", "Record every API request and response to a cassette file (secrets are redacted)")
+	RootCmd.PersistentFlags().StringVar(&replayFile, "replay", "", "Answer API requests from a cassette file recorded with --record instead of the network")
+	RootCmd.MarkFlagsMutuallyExclusive("record", "replay")
 	cobra.OnInitialize(initConfig)
 	RootCmd.AddCommand(bucket.ObjectStorageCmd)
 	RootCmd.AddCommand(instance.InstanceCmd)
@@ -56,6 +135,10 @@ func init() {
 	RootCmd.AddCommand(dns.DnsCmd)
 	// RootCmd.AddCommand(events.EventsCmd) // Commented out as events package is not implemented
 	RootCmd.AddCommand(finance.FinanceCmd)
+	RootCmd.AddCommand(dev.DevCmd)
+	RootCmd.AddCommand(profile.ProfileCmd)
+	RootCmd.AddCommand(config.ConfigCmd)
+	RootCmd.AddCommand(auth.AuthCmd)
 
 }
 
@@ -76,16 +159,28 @@ func initConfig() {
 	if err := viper.ReadInConfig(); err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
 		if errors.As(err, &configFileNotFoundError) {
-			// Config file not found; create it
-			// Set default values for other configuration options as needed
-			viper.SetDefault("auth.token", "")
-			viper.SetDefault("default.zoneId", "")
-			viper.SetDefault("default.zoneName", "")
-			err := viper.SafeWriteConfig()
+			// Config file not found; create it with an empty default profile
+			err := cli.EditConfig(func(settings map[string]any) error {
+				settings["activeprofile"] = cli.DefaultProfile
+				settings["profiles"] = map[string]any{
+					cli.DefaultProfile: map[string]any{
+						"auth":    map[string]any{"token": ""},
+						"default": map[string]any{"zoneid": "", "zonename": ""},
+					},
+				}
+				return nil
+			})
 			if err != nil {
 				fmt.Fprintln(os.Stderr, "Unable to write default config:", err)
-				return
 			}
 		}
+		return
+	}
+
+	cli.WarnInsecureConfig()
+
+	// Configs written before profiles existed keep their token in the default profile
+	if err := cli.MigrateLegacyConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, "Unable to migrate config to profiles:", err)
 	}
 }
@@ -33,24 +33,22 @@ var userProfileCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		resp, err := httpClient.GetUserProfile()
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetUserProfile(cmd.Context())
 		if err != nil {
 			slog.Error("failed to get user profile", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("user profile retrieved successfully")
-		fmt.Println("User profile retrieved successfully.")
+		presenter.Status("User profile retrieved successfully.")
 
-		// Render profile in table format
-		presenter.RenderUserProfile(resp)
-
-		return nil
+		return presenter.RenderUserProfile(resp)
 	},
 }
 
 func init() {
 	UserCmd.AddCommand(userProfileCmd)
+	cli.RequireAbilities(userProfileCmd, "user:read")
 	_ = cli.BindFlagsFromStruct(userProfileCmd, &profileOpt)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	httpc "github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -38,20 +39,21 @@ var sshKeyCreateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		if _, err := httpClient.AddUserSSHKey(createOpt.Name, createOpt.PublicKey); err != nil {
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.AddUserSSHKey(cmd.Context(), createOpt.Name, createOpt.PublicKey)
+		if err != nil {
 			slog.Error("failed to create SSH key", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("SSH key created successfully", "name", createOpt.Name)
-		fmt.Println("SSH key created successfully.")
-		return nil
+		return presenter.Result(resp, "SSH key created successfully.")
 	},
 }
 
 func init() {
 	UserSSHKeyCmd.AddCommand(sshKeyCreateCmd)
+	cli.RequireAbilities(sshKeyCreateCmd, "user:write")
 
 	_ = cli.BindFlagsFromStruct(sshKeyCreateCmd, &createOpt)
 }
\ No newline at end of file
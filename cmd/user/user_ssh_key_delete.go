@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	httpc "github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -35,19 +36,24 @@ var userSSHKeyDeleteCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		if _, err := httpClient.DeleteUserSSHKey(sshKeyDeleteOpt.ID); err != nil {
+		if ok, err := cli.Confirm(cmd.Context(), "delete", cli.SSHKey.Target(sshKeyDeleteOpt.ID)); !ok {
+			return err
+		}
+
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.DeleteUserSSHKey(cmd.Context(), sshKeyDeleteOpt.ID)
+		if err != nil {
 			slog.Error("failed to delete SSH key", "error", err, "id", sshKeyDeleteOpt.ID)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("SSH key deleted successfully", "id", sshKeyDeleteOpt.ID)
-		fmt.Println("SSH key deleted successfully.")
-		return nil
+		return presenter.Result(resp, "SSH key deleted successfully.")
 	},
 }
 
 func init() {
 	UserSSHKeyCmd.AddCommand(userSSHKeyDeleteCmd)
+	cli.RequireAbilities(userSSHKeyDeleteCmd, "user:write")
 	_ = cli.BindFlagsFromStruct(userSSHKeyDeleteCmd, &sshKeyDeleteOpt)
 }
\ No newline at end of file
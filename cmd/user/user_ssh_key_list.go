@@ -11,7 +11,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type sshKeyListOptions struct{}
+type sshKeyListOptions struct {
+	presenter.ListOptions
+}
 
 var sshKeyListOpt sshKeyListOptions
 
@@ -33,23 +35,28 @@ var userSSHKeyListCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &sshKeyListOpt); err != nil {
 			return err
 		}
+		if sshKeyListOpt.ListColumns {
+			return presenter.ListColumns(presenter.SSHKeyColumns)
+		}
+		list, err := presenter.NewList(sshKeyListOpt.ListOptions, presenter.SSHKeyColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
 
-		httpClient := http.NewClient(token)
-		resp, err := httpClient.ListUserSSHKeys()
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.ListUserSSHKeys(cmd.Context())
 		if err != nil {
 			slog.Error("failed to list SSH keys", "error", err)
 			return fmt.Errorf("could not list SSH keys: %w", err)
 		}
 
 		slog.Info("successfully retrieved SSH keys", "count", len(resp.UserData))
-		presenter.RenderSSHKeyList(resp.UserData)
-		fmt.Println("SSH keys listed successfully.")
-
-		return nil
+		return list.Output(resp, resp.UserData)
 	},
 }
 
 func init() {
 	UserSSHKeyCmd.AddCommand(userSSHKeyListCmd)
+	cli.RequireAbilities(userSSHKeyListCmd, "user:read")
 	_ = cli.BindFlagsFromStruct(userSSHKeyListCmd, &sshKeyListOpt)
 }
\ No newline at end of file
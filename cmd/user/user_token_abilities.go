@@ -34,16 +34,15 @@ var userTokenAbilitiesCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		resp, err := httpClient.GetUserTokenAbilities()
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		resp, err := httpClient.GetUserTokenAbilities(cmd.Context())
 		if err != nil {
 			slog.Error("failed to fetch token abilities", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("token abilities retrieved successfully")
-		presenter.RenderTokenAbilities(resp.Abilities)
-		return nil
+		return presenter.RenderTokenAbilities(resp)
 	},
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	httpc "github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -30,17 +31,16 @@ var userTokenValidateCmd = &cobra.Command{
 			return err
 		}
 
-		httpClient := httpc.NewClient(token)
-		if err := httpClient.ValidateUserToken(); err != nil {
+		httpClient := httpc.NewClient(token, cli.ClientOptions()...)
+		if err := httpClient.ValidateUserToken(cmd.Context()); err != nil {
 			slog.Error("failed to validate user token", "error", err)
 			return fmt.Errorf("error: %w", err)
 		}
 
 		slog.Info("user token validated successfully")
-		fmt.Println("Token is valid")
 		// Note: Expiration information is not provided by the validation endpoint
 		// Token expiration details may be available through other means
-		return nil
+		return presenter.Result(nil, "Token is valid")
 	},
 }
 
@@ -5,14 +5,31 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
+type listOptions struct {
+	presenter.ListOptions
+}
+
+var listOpts listOptions
+
+var zoneColumns = []presenter.Column[responses.Zone]{
+	{Path: "name", Header: "Name"},
+	{Path: "id", Header: "ID"},
+	{Path: "location", Header: "Location"},
+	{Path: "active", Header: "Active"},
+}
+
 // listCmd represents the list command
 var listCmd = &cobra.Command{
 	Use:   "list",
@@ -25,16 +42,42 @@ var listCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli.LoadFromCobraFlags(cmd, &listOpts); err != nil {
+			return err
+		}
+		// # numbers the zones as the API returns them, for the prompt below,
+		// whatever the filters and sorting
+		var zones *responses.DataCenter
+		columns := append([]presenter.Column[responses.Zone]{{Path: "#", Header: "#", Value: func(zone responses.Zone) string {
+			for i := range zones.Data {
+				if zones.Data[i].ID == zone.ID {
+					return strconv.Itoa(i + 1)
+				}
+			}
+			return ""
+		}}}, zoneColumns...)
+		if listOpts.ListColumns {
+			return presenter.ListColumns(columns)
+		}
+		list, err := presenter.NewList(listOpts.ListOptions, columns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+
 		token := cli.TokenFromContext(cmd.Context())
-		httpClient := http.NewClient(token)
-		zones, err := httpClient.GetZoneList()
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		zones, err = httpClient.GetZoneList(cmd.Context())
 		if err != nil {
 			slog.Error("failed to get zone list", "error", err)
 			return fmt.Errorf("failed to get zone list: %w", err)
 		}
 		slog.Info("successfully retrieved zone list", "count", len(zones.Data))
-		for i, zone := range zones.Data {
-			fmt.Printf("[%d] Zone Name: %s, ID: %s \n", i+1, zone.Name, zone.ID)
+		if err := list.Output(zones, zones.Data); err != nil {
+			return err
+		}
+		// Only offer the prompt to people, scripts use 'virak-cli config set default.zoneId'
+		if !presenter.Tabular() || !term.IsTerminal(int(os.Stdin.Fd())) {
+			return nil
 		}
 
 		// Ask user if they want to set a default zone
@@ -50,24 +93,20 @@ var listCmd = &cobra.Command{
 			_, err := fmt.Sscanf(zoneInput, "%d", &zoneNumber)
 			if err != nil {
 				slog.Error("failed to read zone number", "error", err)
-				fmt.Println("Invalid input.")
-				return nil
+				return cli.UsageError(fmt.Errorf("invalid input %q, expected a zone number", zoneInput))
 			}
 			if zoneNumber < 1 || zoneNumber > len(zones.Data) {
 				slog.Error("invalid zone number", "zoneNumber", zoneNumber)
-				fmt.Println("Invalid zone number.")
-				return nil
+				return cli.UsageError(fmt.Errorf("invalid zone number %d, expected 1 to %d", zoneNumber, len(zones.Data)))
 			}
 			defaultZoneID := zones.Data[zoneNumber-1].ID
 			defaultZoneName := zones.Data[zoneNumber-1].Name
-			err = cli.SetDefaultZone(defaultZoneID, defaultZoneName)
-			if err != nil {
+			if err := cli.SetDefaultZone(defaultZoneID, defaultZoneName); err != nil {
 				slog.Error("failed to save default zone to config", "error", err)
-				fmt.Println("Failed to save default zone to config:", err)
-			} else {
-				slog.Info("default zone set", "zoneName", defaultZoneName, "zoneId", defaultZoneID)
-				fmt.Println("Default zone set to:", defaultZoneName)
+				return fmt.Errorf("failed to save default zone to config: %w", err)
 			}
+			slog.Info("default zone set", "zoneName", defaultZoneName, "zoneId", defaultZoneID)
+			fmt.Println("Default zone set to:", defaultZoneName)
 		}
 		return nil
 	},
@@ -75,4 +114,6 @@ var listCmd = &cobra.Command{
 
 func init() {
 	ZoneCmd.AddCommand(listCmd)
+	cli.RequireAbilities(listCmd, "zone:read")
+	_ = cli.BindFlagsFromStruct(listCmd, &listOpts)
 }
@@ -7,15 +7,29 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 type zoneNetworksOptions struct {
 	ZoneID string `flag:"zoneId" desc:"Zone ID to use (optional if default.zoneId is set in config, overrides positional argument if set)"`
+	presenter.ListOptions
 }
 
 var networksOpt zoneNetworksOptions
 
+var zoneNetworkColumns = []presenter.Column[responses.Network]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "status", Header: "Status"},
+	{Path: "offering", Header: "Offering", Value: func(net responses.Network) string {
+		return fmt.Sprintf("%s (%s)", net.NetworkOffering.DisplayName, net.NetworkOffering.Name)
+	}},
+	{Path: "network_offering.type", Header: "Type"},
+	{Path: "network_offering.networkrate", Header: "Rate (Mbps)"},
+}
+
 // networksCmd represents the networks command
 var networksCmd = &cobra.Command{
 	Use:   "networks",
@@ -35,23 +49,26 @@ var networksCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &networksOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		networks, err := httpClient.ListNetworks(zoneID)
+		if networksOpt.ListColumns {
+			return presenter.ListColumns(zoneNetworkColumns)
+		}
+		list, err := presenter.NewList(networksOpt.ListOptions, zoneNetworkColumns)
+		if err != nil {
+			return cli.UsageError(err)
+		}
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		networks, err := httpClient.ListNetworks(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get zone networks", "error", err, "zoneId", zoneID)
 			return fmt.Errorf("error: %w", err)
 		}
 		slog.Info("successfully retrieved zone networks", "zoneId", zoneID, "count", len(networks.Data))
-		fmt.Println("Networks for Zone:")
-		for i, net := range networks.Data {
-			fmt.Printf("[%d] Name: %s, ID: %s, Status: %s\n", i+1, net.Name, net.ID, net.Status)
-			fmt.Printf("    Offering: %s (%s), Type: %s, Rate: %d Mbps\n", net.NetworkOffering.DisplayName, net.NetworkOffering.Name, net.NetworkOffering.Type, net.NetworkOffering.NetworkRate)
-		}
-		return nil
+		return list.Output(networks, networks.Data)
 	},
 }
 
 func init() {
 	ZoneCmd.AddCommand(networksCmd)
+	cli.RequireAbilities(networksCmd, "network:read")
 	_ = cli.BindFlagsFromStruct(networksCmd, &networksOpt)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -36,23 +37,25 @@ You must provide the zone id as an argument, or use --default-zone or --zoneId f
 		if err := cli.LoadFromCobraFlags(cmd, &resourcesOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		resources, err := httpClient.GetZoneCustomerResource(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		resources, err := httpClient.GetZoneCustomerResource(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get zone resources", "error", err, "zoneId", zoneID)
 			return fmt.Errorf("error: %w", err)
 		}
 		slog.Info("successfully retrieved zone resources", "zoneId", zoneID)
-		fmt.Println("Resources for Zone:")
-		fmt.Printf("  Memory: %d/%d (Megabyte)\n", resources.InstanceResourceCollected.Memory.Collected, resources.InstanceResourceCollected.Memory.Total)
-		fmt.Printf("  CPU Number: %d/%d (Core)\n", resources.InstanceResourceCollected.CPUNumber.Collected, resources.InstanceResourceCollected.CPUNumber.Total)
-		fmt.Printf("  Data Volume: %d/%d (Gigabyte)\n", resources.InstanceResourceCollected.DataVolume.Collected, resources.InstanceResourceCollected.DataVolume.Total)
-		fmt.Printf("  VM Limit: %d/%d\n", resources.InstanceResourceCollected.VMLimit.Collected, resources.InstanceResourceCollected.VMLimit.Total)
-		return nil
+		collected := resources.InstanceResourceCollected
+		table := presenter.NewTable("Resource", "Used", "Total", "Unit")
+		table.Append("Memory", fmt.Sprintf("%d", collected.Memory.Collected), fmt.Sprintf("%d", collected.Memory.Total), "MB")
+		table.Append("CPU", fmt.Sprintf("%d", collected.CPUNumber.Collected), fmt.Sprintf("%d", collected.CPUNumber.Total), "cores")
+		table.Append("Data Volume", fmt.Sprintf("%d", collected.DataVolume.Collected), fmt.Sprintf("%d", collected.DataVolume.Total), "GB")
+		table.Append("VM Limit", fmt.Sprintf("%d", collected.VMLimit.Collected), fmt.Sprintf("%d", collected.VMLimit.Total), "VMs")
+		return presenter.Output(resources, table)
 	},
 }
 
 func init() {
 	ZoneCmd.AddCommand(resourcesCmd)
+	cli.RequireAbilities(resourcesCmd, "zone:read")
 	_ = cli.BindFlagsFromStruct(resourcesCmd, &resourcesOpt)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/internal/presenter"
 	"github.com/virak-cloud/cli/pkg/http"
 )
 
@@ -35,24 +36,25 @@ var servicesCmd = &cobra.Command{
 		if err := cli.LoadFromCobraFlags(cmd, &servicesOpt); err != nil {
 			return err
 		}
-		httpClient := http.NewClient(token)
-		services, err := httpClient.GetZoneActiveServices(zoneID)
+		httpClient := http.NewClient(token, cli.ClientOptions()...)
+		services, err := httpClient.GetZoneActiveServices(cmd.Context(), zoneID)
 		if err != nil {
 			slog.Error("failed to get zone active services", "error", err, "zoneId", zoneID)
 			return fmt.Errorf("error: %w", err)
 		}
 		slog.Info("successfully retrieved zone active services", "zoneId", zoneID)
-		fmt.Println("Active Services for Zone:")
-		fmt.Printf("  Instance: %v\n", services.Instance)
-		fmt.Printf("  DataVolume: %v\n", services.DataVolume)
-		fmt.Printf("  Network: %v\n", services.Network)
-		fmt.Printf("  ObjectStorage: %v\n", services.ObjectStorage)
-		fmt.Printf("  K8s: %v\n", services.K8s)
-		return nil
+		table := presenter.NewTable("Service", "Active")
+		table.Append("Instance", fmt.Sprintf("%t", services.Instance))
+		table.Append("DataVolume", fmt.Sprintf("%t", services.DataVolume))
+		table.Append("Network", fmt.Sprintf("%t", services.Network))
+		table.Append("ObjectStorage", fmt.Sprintf("%t", services.ObjectStorage))
+		table.Append("K8s", fmt.Sprintf("%t", services.K8s))
+		return presenter.Output(services, table)
 	},
 }
 
 func init() {
 	ZoneCmd.AddCommand(servicesCmd)
+	cli.RequireAbilities(servicesCmd, "zone:read")
 	_ = cli.BindFlagsFromStruct(servicesCmd, &servicesOpt)
 }
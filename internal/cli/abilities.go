@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// abilitiesAnnotation is the cobra annotation holding the comma-separated
+// abilities a command needs.
+const abilitiesAnnotation = "virak-cli/abilities"
+
+// RequireAbilities declares the token abilities cmd needs, e.g. instance:write.
+// Preflight checks them before the command calls the API.
+func RequireAbilities(cmd *cobra.Command, abilities ...string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[abilitiesAnnotation] = strings.Join(abilities, ",")
+}
+
+// RequiredAbilities returns the abilities declared with RequireAbilities.
+func RequiredAbilities(cmd *cobra.Command) []string {
+	value := cmd.Annotations[abilitiesAnnotation]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// HasAbility reports whether granted includes ability, either literally or
+// through the "*" and "<resource>:*" wildcards.
+func HasAbility(granted []string, ability string) bool {
+	resource, _, _ := strings.Cut(ability, ":")
+	for _, g := range granted {
+		if g == "*" || g == ability || g == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingAbilities returns the abilities of required that granted lacks.
+func MissingAbilities(granted, required []string) []string {
+	var missing []string
+	for _, ability := range required {
+		if !HasAbility(granted, ability) {
+			missing = append(missing, ability)
+		}
+	}
+	return missing
+}
+
+// abilityResources are the resources commands declare abilities on.
+var abilityResources = []string{"zone", "instance", "network", "bucket", "kubernetes", "dns", "finance", "user"}
+
+// followsAbilityNaming reports whether granted only holds abilities named the
+// way RequireAbilities names them, e.g. "instance:read" or "dns:*". Tokens
+// with other names are left to the API, since comparing them with the
+// required abilities would only block commands the token may well allow.
+func followsAbilityNaming(granted []string) bool {
+	if len(granted) == 0 {
+		return false
+	}
+	for _, g := range granted {
+		if g == "*" {
+			continue
+		}
+		resource, action, ok := strings.Cut(g, ":")
+		if !ok || !slices.Contains(abilityResources, resource) || !slices.Contains([]string{"read", "write", "*"}, action) {
+			return false
+		}
+	}
+	return true
+}
+
+// abilitiesCacheTTL is how long the abilities of a token are reused by later
+// runs of the CLI before the API is asked again.
+const abilitiesCacheTTL = 10 * time.Minute
+
+// abilitiesCache is the file the abilities of a token are cached in. Key
+// identifies the token and API URL without revealing the token.
+type abilitiesCache struct {
+	Key       string   `json:"key"`
+	Abilities []string `json:"abilities"`
+}
+
+var (
+	abilitiesMu    sync.Mutex
+	tokenAbilities = map[string][]string{}
+)
+
+// TokenAbilities returns the abilities of token. They are asked from the API
+// once and then cached for abilitiesCacheTTL in the active profile's cache
+// directory.
+func TokenAbilities(ctx context.Context, token string) ([]string, error) {
+	abilitiesMu.Lock()
+	defer abilitiesMu.Unlock()
+	key := hashKey(APIURL(), token)
+	if abilities, ok := tokenAbilities[key]; ok {
+		return abilities, nil
+	}
+	path, err := abilitiesCachePath()
+	if err == nil {
+		if abilities, ok := readAbilitiesCache(path, key); ok {
+			tokenAbilities[key] = abilities
+			return abilities, nil
+		}
+	}
+	resp, err := http.NewClient(token, ClientOptions()...).GetUserTokenAbilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokenAbilities[key] = resp.Abilities
+	if path != "" {
+		writeAbilitiesCache(path, abilitiesCache{Key: key, Abilities: resp.Abilities})
+	}
+	return resp.Abilities, nil
+}
+
+func abilitiesCachePath() (string, error) {
+	dir, err := CacheDir(ActiveProfile())
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "abilities.json"), nil
+}
+
+func readAbilitiesCache(path, key string) ([]string, bool) {
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > abilitiesCacheTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var cache abilitiesCache
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Key != key {
+		return nil, false
+	}
+	return cache.Abilities, true
+}
+
+// writeAbilitiesCache saves cache to path. Failing to only costs an API call
+// in the next run.
+func writeAbilitiesCache(path string, cache abilitiesCache) {
+	data, err := json.Marshal(cache)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0o700)
+	}
+	if err == nil {
+		err = os.WriteFile(path, data, 0o600)
+	}
+	if err != nil {
+		slog.Debug("failed to cache token abilities", "path", path, "error", err)
+	}
+}
+
+// abilityChecksEnabled reports whether auth.checkAbilities allows checking
+// abilities before calling the API. Checks are on unless it is set to false.
+func abilityChecksEnabled() bool {
+	key := profileSetting("auth.checkAbilities")
+	return !viper.IsSet(key) || viper.GetBool(key)
+}
+
+// checkAbilities fails when token lacks an ability cmd requires. When the
+// abilities cannot be read, or are not named like the required ones, the check
+// is skipped and the API has the last word.
+func checkAbilities(cmd *cobra.Command, token string) error {
+	required := RequiredAbilities(cmd)
+	if len(required) == 0 || !abilityChecksEnabled() {
+		return nil
+	}
+	granted, err := TokenAbilities(cmd.Context(), token)
+	if err != nil {
+		slog.Warn("failed to read token abilities, skipping ability check", "error", err)
+		return nil
+	}
+	if !followsAbilityNaming(granted) {
+		slog.Debug("token abilities are not named like resource:action, skipping ability check", "abilities", granted)
+		return nil
+	}
+	missing := MissingAbilities(granted, required)
+	if len(missing) == 0 {
+		return nil
+	}
+	slog.Error("token lacks required abilities", "command", cmd.CommandPath(), "missing", missing)
+	return AuthError(fmt.Errorf("the token of profile %q lacks %s, required by '%s'. Run 'virak-cli auth whoami' to see its abilities",
+		ActiveProfile(), strings.Join(missing, ", "), cmd.CommandPath()))
+}
+
+// ForbiddenHint explains an API 403 response to cmd in terms of the abilities
+// the command needs. It returns "" for other errors.
+func ForbiddenHint(cmd *cobra.Command, err error) string {
+	if cmd == nil || !http.IsForbidden(err) {
+		return ""
+	}
+	hint := fmt.Sprintf("The API refused the request: the token of profile %q is not allowed to do this.", ActiveProfile())
+	if required := RequiredAbilities(cmd); len(required) > 0 {
+		hint += fmt.Sprintf(" '%s' requires %s.", cmd.CommandPath(), strings.Join(required, ", "))
+	}
+	return hint + " Run 'virak-cli auth whoami' to see the token's abilities."
+}
@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+func TestHasAbility(t *testing.T) {
+	tests := []struct {
+		granted []string
+		ability string
+		want    bool
+	}{
+		{[]string{"instance:read"}, "instance:read", true},
+		{[]string{"instance:read"}, "instance:write", false},
+		{[]string{"*"}, "instance:write", true},
+		{[]string{"instance:*"}, "instance:write", true},
+		{[]string{"instance:*"}, "network:read", false},
+		{[]string{"network:read", "instance:*"}, "instance:read", true},
+		{[]string{"inst*"}, "instance:read", false},
+		{[]string{"*:read"}, "instance:read", false},
+		{[]string{"instance"}, "instance:read", false},
+		{nil, "instance:read", false},
+	}
+	for _, tt := range tests {
+		if got := HasAbility(tt.granted, tt.ability); got != tt.want {
+			t.Errorf("HasAbility(%q, %q) = %t, want %t", tt.granted, tt.ability, got, tt.want)
+		}
+	}
+
+	missing := MissingAbilities([]string{"zone:read", "instance:*"}, []string{"zone:read", "instance:write", "network:write", "dns:read"})
+	if !slices.Equal(missing, []string{"network:write", "dns:read"}) {
+		t.Errorf("got missing %q, want [network:write dns:read]", missing)
+	}
+}
+
+func TestFollowsAbilityNaming(t *testing.T) {
+	tests := []struct {
+		granted []string
+		want    bool
+	}{
+		{[]string{"*"}, true},
+		{[]string{"instance:read", "dns:*", "zone:write"}, true},
+		{[]string{"instance:read", "servers.manage"}, false},
+		{[]string{"instance:delete"}, false},
+		{[]string{"vm:read"}, false},
+		{[]string{"instance"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := followsAbilityNaming(tt.granted); got != tt.want {
+			t.Errorf("followsAbilityNaming(%q) = %t, want %t", tt.granted, got, tt.want)
+		}
+	}
+}
+
+// countingAPI starts a fake API for the CLI to use and returns a counter of
+// the requests whose path ends in suffix.
+func countingAPI(t *testing.T, suffix string, opts ...fake.Option) *int {
+	t.Helper()
+	calls := 0
+	api := fake.NewServer(opts...)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, suffix) {
+			calls++
+		}
+		api.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	viper.Set("apiUrl", srv.URL)
+	return &calls
+}
+
+// abilitiesAPI starts a fake API granting abilities and returns a counter of
+// the abilities requests.
+func abilitiesAPI(t *testing.T, abilities ...string) *int {
+	t.Helper()
+	tempHome(t)
+	calls := countingAPI(t, "/user/token-abilities", fake.WithAbilities(abilities))
+	// Every run of the CLI starts without abilities in memory
+	forget := func() { tokenAbilities = map[string][]string{} }
+	forget()
+	t.Cleanup(forget)
+	return calls
+}
+
+func abilitiesOf(t *testing.T, token string) []string {
+	t.Helper()
+	abilities, err := TokenAbilities(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return abilities
+}
+
+func TestTokenAbilitiesCache(t *testing.T) {
+	calls := abilitiesAPI(t, "zone:read", "instance:*")
+
+	if got := abilitiesOf(t, "token-1"); !slices.Equal(got, []string{"zone:read", "instance:*"}) {
+		t.Fatalf("got abilities %q, want the granted ones", got)
+	}
+	abilitiesOf(t, "token-1")
+	tokenAbilities = map[string][]string{}
+	abilitiesOf(t, "token-1")
+	if *calls != 1 {
+		t.Errorf("asked the API %d times, want once with the abilities cached", *calls)
+	}
+	path, err := abilitiesCachePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); strings.Contains(string(data), "token-1") {
+		t.Errorf("the cache contains the token:\n%s", data)
+	}
+
+	// Another token has its own abilities
+	abilitiesOf(t, "token-2")
+	if *calls != 2 {
+		t.Errorf("asked the API %d times, want again for a new token", *calls)
+	}
+	tokenAbilities = map[string][]string{}
+	abilitiesOf(t, "token-1")
+	if *calls != 3 {
+		t.Errorf("asked the API %d times, want again for the token the cache was not written for", *calls)
+	}
+
+	// The cache expires
+	tokenAbilities = map[string][]string{}
+	old := time.Now().Add(-abilitiesCacheTTL - time.Minute)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+	abilitiesOf(t, "token-1")
+	if *calls != 4 {
+		t.Errorf("asked the API %d times, want again after the cache expired", *calls)
+	}
+
+	// The API URL is part of the key
+	tokenAbilities = map[string][]string{}
+	viper.Set("apiUrl", viper.GetString("apiUrl")+"/")
+	abilitiesOf(t, "token-1")
+	if *calls != 5 {
+		t.Errorf("asked the API %d times, want again for another API URL", *calls)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
@@ -17,7 +18,10 @@ type FlagSpec struct {
 	Def   string
 }
 
-// BindFlagsFromStruct declares flags based on struct tags.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BindFlagsFromStruct declares flags based on struct tags. The fields of
+// embedded structs, such as presenter.ListOptions, are declared too.
 // Supported tags: flag, usage, default
 func BindFlagsFromStruct(cmd *cobra.Command, opts any) error {
 	t := reflect.TypeOf(opts)
@@ -30,12 +34,23 @@ func BindFlagsFromStruct(cmd *cobra.Command, opts any) error {
 
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := BindFlagsFromStruct(cmd, reflect.New(f.Type).Interface()); err != nil {
+				return err
+			}
+			continue
+		}
 		name := f.Tag.Get("flag")
 		if name == "" {
 			continue
 		}
 		usage := f.Tag.Get("usage")
 		def := f.Tag.Get("default")
+		if f.Type == durationType {
+			defDuration, _ := time.ParseDuration(def)
+			cmd.Flags().Duration(name, defDuration, usage)
+			continue
+		}
 		switch f.Type.Kind() {
 		case reflect.String:
 			cmd.Flags().String(name, def, usage)
@@ -73,8 +88,9 @@ func LoadFromViper(opts any) error {
 }
 
 // LoadFromCobraFlags reads values of flags defined on cmd according to `flag` tags
-// on the fields of opts (a pointer to struct) and writes them into opts.
-// Supported field kinds: string, bool.
+// on the fields of opts (a pointer to struct), including those of embedded
+// structs, and writes them into opts.
+// Supported field kinds: string, bool, int, []string and time.Duration.
 func LoadFromCobraFlags(cmd *cobra.Command, opts any) error {
 	v := reflect.ValueOf(opts)
 	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
@@ -85,6 +101,12 @@ func LoadFromCobraFlags(cmd *cobra.Command, opts any) error {
 
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := LoadFromCobraFlags(cmd, v.Field(i).Addr().Interface()); err != nil {
+				return err
+			}
+			continue
+		}
 		flagName := field.Tag.Get("flag")
 		if flagName == "" {
 			continue
@@ -97,6 +119,15 @@ func LoadFromCobraFlags(cmd *cobra.Command, opts any) error {
 		var (
 			err error
 		)
+		if field.Type == durationType {
+			var val time.Duration
+			val, err = cmd.Flags().GetDuration(flagName)
+			if err != nil {
+				return fmt.Errorf("reading flag %q: %w", flagName, err)
+			}
+			fv.SetInt(int64(val))
+			continue
+		}
 		switch field.Type.Kind() {
 		case reflect.String:
 			var val string
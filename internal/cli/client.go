@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// cassette is the record or replay option set up by OpenCassette. It is shared
+// by every client created during the invocation.
+var cassette http.Option
+
+// OpenCassette prepares the --record or --replay mode for this invocation. It
+// must be called before the first client is created.
+func OpenCassette(record, replay string) error {
+	switch {
+	case record != "" && replay != "":
+		return errors.New("--record and --replay cannot be used together")
+	case record != "":
+		rec, err := http.NewRecorder(record)
+		if err != nil {
+			return err
+		}
+		cassette = http.WithRecorder(rec)
+	case replay != "":
+		rep, err := http.NewReplayer(replay)
+		if err != nil {
+			return err
+		}
+		cassette = http.WithReplay(rep)
+	}
+	return nil
+}
+
+// ClientOptions returns the API client options derived from global flags and config.
+func ClientOptions() []http.Option {
+	opts := profileClientOptions(ActiveProfile())
+	if refresh := tokenRefresher(); refresh != nil {
+		opts = append(opts, http.WithTokenRefresh(refresh))
+	}
+	if DryRun() {
+		opts = append(opts, http.WithDryRun(os.Stdout))
+	}
+	return opts
+}
+
+// profileClientOptions returns the client options of profile, without the
+// active profile's credential helper.
+func profileClientOptions(profile string) []http.Option {
+	var opts []http.Option
+	// The cassette wraps the network transport, so tracing still logs replayed
+	// requests.
+	if cassette != nil {
+		opts = append(opts, cassette)
+	}
+	opts = append(opts,
+		http.WithRetries(viper.GetInt("retries")),
+		http.WithRateLimit(http.RateLimit{
+			RequestsPerSecond: viper.GetFloat64(profileSettingOf(profile, "rateLimit.requestsPerSecond")),
+			Burst:             viper.GetInt(profileSettingOf(profile, "rateLimit.burst")),
+			MaxInFlight:       viper.GetInt(profileSettingOf(profile, "rateLimit.maxInFlight")),
+		}),
+	)
+	if url := apiURLOf(profile); url != "" {
+		opts = append(opts, http.WithBaseURL(url))
+	}
+	if trace := viper.GetBool("trace"); trace || viper.GetBool("debug") {
+		opts = append(opts, http.WithTracing(nil, trace))
+	}
+	return opts
+}
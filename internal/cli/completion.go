@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// completionTTL is how long completion reuses the resources it listed, so
+// pressing tab again does not call the API every time.
+const completionTTL = 2 * time.Minute
+
+// completedFlags are the flags RegisterCompletions completes from the API.
+var completedFlags = map[string]Resource{
+	"zoneId":              Zone,
+	"instanceId":          Instance,
+	"instance-id":         Instance,
+	"networkId":           Network,
+	"bucketId":            Bucket,
+	"clusterId":           Cluster,
+	"volumeId":            Volume,
+	"sshKeyId":            SSHKey,
+	"domain":              Domain,
+	"record":              Record,
+	"service-offering-id": InstanceServiceOffering,
+	"serviceOfferingId":   VolumeServiceOffering,
+	"network-offering-id": NetworkServiceOffering,
+	"offeringId":          KubernetesServiceOffering,
+	"vm-image-id":         VMImage,
+	"versionId":           KubernetesVersion,
+}
+
+// RegisterCompletions registers shell completion for the flags of cmd and its
+// subcommands: resource IDs, and names where resolvedFlags accepts them, from
+// the API, and the values of flags declared with Enum.
+func RegisterCompletions(cmd *cobra.Command) {
+	register := func(flag *pflag.Flag) {
+		if _, ok := cmd.GetFlagCompletionFunc(flag.Name); ok {
+			return
+		}
+		if values := flag.Annotations[enumAnnotation]; values != nil {
+			_ = cmd.RegisterFlagCompletionFunc(flag.Name, cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp))
+			return
+		}
+		if resource, ok := completedFlags[flag.Name]; ok {
+			_, byName := resolvedFlags[flag.Name]
+			_ = cmd.RegisterFlagCompletionFunc(flag.Name, completeResource(resource, byName))
+		}
+	}
+	cmd.LocalNonPersistentFlags().VisitAll(register)
+	cmd.PersistentFlags().VisitAll(register)
+	for _, sub := range cmd.Commands() {
+		RegisterCompletions(sub)
+	}
+}
+
+// completeResource completes the IDs of resource, described by their names,
+// and with byName the names too.
+func completeResource(resource Resource, byName bool) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		all, err := completionList(cmd, resource)
+		if err != nil {
+			cobra.CompDebugln(fmt.Sprintf("failed to list %ss: %v", resource.Kind, err), true)
+			return nil, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveError
+		}
+		names := map[string]int{}
+		for _, r := range all {
+			names[r.Name]++
+		}
+		var completions []string
+		for _, r := range all {
+			completions = append(completions, r.ID+"\t"+r.Name)
+			if byName && r.Name != "" && names[r.Name] == 1 {
+				completions = append(completions, r.Name+"\t"+r.ID)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completionList returns the resources in the zone or domain cmd is given,
+// from the cache when it was filled for the same token less than
+// completionTTL ago.
+func completionList(cmd *cobra.Command, resource Resource) ([]namedResource, error) {
+	scope := ""
+	switch {
+	case resource.Zonal:
+		scope, _ = cmd.Flags().GetString("zoneId")
+		if scope == "" {
+			scope = viper.GetString("zoneId")
+		}
+		if scope == "" {
+			scope = ProfileString("default.zoneId")
+		}
+		if scope == "" {
+			return nil, fmt.Errorf("no zone selected")
+		}
+	case resource.inDomain:
+		scope, _ = cmd.Flags().GetString("domain")
+		if scope == "" {
+			return nil, fmt.Errorf("no --domain given")
+		}
+	}
+
+	token, err := tokenWithoutPrompt()
+	if errors.Is(err, errNeedsInput) {
+		cobra.CompDebugln("not completing, reading the token needs user input", false)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	path, err := completionCachePath(resource, scope, token)
+	if err == nil {
+		if all, ok := readCompletionCache(path); ok {
+			return all, nil
+		}
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	all, err := resource.list(ctx, http.NewClient(token, ClientOptions()...), scope)
+	if err != nil {
+		return nil, err
+	}
+	// An empty list is likely to change soon, e.g. right after a create
+	if path != "" && len(all) > 0 {
+		writeCompletionCache(path, all)
+	}
+	return all, nil
+}
+
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// completionCachePath returns the cache file of resource in scope, under the
+// active profile's cache directory. Its name includes a hash of the API URL
+// and token, so neither --api-url and VIRAK_API_URL nor another token, e.g. of
+// another account given with VIRAK_TOKEN, share results with the profile's.
+func completionCachePath(resource Resource, scope, token string) (string, error) {
+	dir, err := CacheDir(ActiveProfile())
+	if err != nil {
+		return "", err
+	}
+	name := unsafePathChars.ReplaceAllString(resource.Kind, "_")
+	if scope != "" {
+		name += "-" + unsafePathChars.ReplaceAllString(scope, "_")
+	}
+	name += "-" + hashKey(APIURL(), token)[:12]
+	return filepath.Join(dir, "completion", name+".json"), nil
+}
+
+func readCompletionCache(path string) ([]namedResource, bool) {
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > completionTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var all []namedResource
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, false
+	}
+	return all, true
+}
+
+// writeCompletionCache saves all to path. Failing to is not worth an error,
+// the next completion asks the API again.
+func writeCompletionCache(path string, all []namedResource) {
+	data, err := json.Marshal(all)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		cobra.CompDebugln(err.Error(), true)
+	}
+}
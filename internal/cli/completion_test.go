@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+func TestCompletionCache(t *testing.T) {
+	tempHome(t)
+	calls := countingAPI(t, "/zone/"+fake.DefaultZoneID+"/network")
+	cmd := &cobra.Command{Use: "show"}
+	cmd.Flags().String("zoneId", fake.DefaultZoneID, "")
+	list := func() {
+		t.Helper()
+		if _, err := completionList(cmd, Network); err != nil {
+			t.Fatal(err)
+		}
+	}
+	viper.Set("token", "token-1")
+	client := http.NewClient("token-1", http.WithBaseURL(viper.GetString("apiUrl")))
+	if _, err := client.CreateL2Network(context.Background(), fake.DefaultZoneID, fakeL2Offering, "web"); err != nil {
+		t.Fatal(err)
+	}
+
+	list()
+	list()
+	if *calls != 1 {
+		t.Errorf("listed the networks %d times, want once with the result cached", *calls)
+	}
+
+	// Another token may belong to another account
+	viper.Set("token", "token-2")
+	list()
+	if *calls != 2 {
+		t.Errorf("listed the networks %d times, want again for a new token", *calls)
+	}
+	path, err := completionCachePath(Network, fake.DefaultZoneID, "token-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(path, "token-2") {
+		t.Errorf("got cache path %s, want the token hashed", path)
+	}
+	viper.Set("token", "token-1")
+	list()
+	if *calls != 2 {
+		t.Errorf("listed the networks %d times, want the first token's result reused", *calls)
+	}
+
+	// So may another API
+	viper.Set("apiUrl", viper.GetString("apiUrl")+"/")
+	list()
+	if *calls != 3 {
+		t.Errorf("listed the networks %d times, want again for another API URL", *calls)
+	}
+}
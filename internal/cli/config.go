@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ConfigFile returns the path of the config file in use. It defaults to
+// ~/.virak-cli.yaml when no config file has been read yet.
+func ConfigFile() string {
+	if path := viper.ConfigFileUsed(); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".virak-cli.yaml"
+	}
+	return filepath.Join(home, ".virak-cli.yaml")
+}
+
+// ReadConfigFile returns the settings stored in the config file, without flag
+// values, environment variables or defaults. A missing file has no settings.
+func ReadConfigFile() (map[string]any, error) {
+	file := viper.New()
+	file.SetConfigFile(ConfigFile())
+	file.SetConfigType("yaml")
+	if err := file.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	return file.AllSettings(), nil
+}
+
+// EditConfig applies edit to the settings stored in the config file and writes
+// them back, creating the file if needed. Only the file's own settings are
+// written, never flag values or defaults. The global viper config is reloaded
+// afterwards so the change is visible to the rest of the command.
+func EditConfig(edit func(settings map[string]any) error) error {
+	path := ConfigFile()
+	settings, err := ReadConfigFile()
+	if err != nil {
+		return err
+	}
+	if err := edit(settings); err != nil {
+		return err
+	}
+
+	out := viper.New()
+	out.SetConfigType("yaml")
+	out.SetConfigPermissions(0600)
+	if err := out.MergeConfigMap(settings); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := out.WriteConfigAs(path); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	// The permissions above only apply to new files
+	if err := os.Chmod(path, 0600); err != nil {
+		return fmt.Errorf("failed to restrict config file permissions: %w", err)
+	}
+
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to reload config: %w", err)
+	}
+	return nil
+}
+
+// getPath returns the value at a dotted key in nested settings, or nil.
+func getPath(settings map[string]any, key string) any {
+	parts := strings.Split(strings.ToLower(key), ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := settings[part].(map[string]any)
+		if !ok {
+			return nil
+		}
+		settings = child
+	}
+	return settings[parts[len(parts)-1]]
+}
+
+// setPath stores value at a dotted key in nested settings, creating
+// intermediate sections as needed.
+func setPath(settings map[string]any, key string, value any) {
+	parts := strings.Split(strings.ToLower(key), ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := settings[part].(map[string]any)
+		if !ok {
+			child = map[string]any{}
+			settings[part] = child
+		}
+		settings = child
+	}
+	settings[parts[len(parts)-1]] = value
+}
+
+// deletePath removes a dotted key from nested settings.
+func deletePath(settings map[string]any, key string) {
+	parts := strings.Split(strings.ToLower(key), ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := settings[part].(map[string]any)
+		if !ok {
+			return
+		}
+		settings = child
+	}
+	delete(settings, parts[len(parts)-1])
+}
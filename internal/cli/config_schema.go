@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ConfigKind is the type of a config value.
+type ConfigKind int
+
+const (
+	KindString ConfigKind = iota
+	KindInt
+	KindFloat
+	KindBool
+)
+
+// ConfigKey describes a setting managed by 'virak-cli config'.
+type ConfigKey struct {
+	// Name is the dotted key, e.g. default.zoneId.
+	Name        string
+	Description string
+	Kind        ConfigKind
+	// Profile keys are stored in the active profile; the others at the top
+	// level of the config. Keys with both set may appear in either place, the
+	// profile's value winning.
+	Profile bool
+	Global  bool
+	// Secret values are masked by 'config list'.
+	Secret bool
+	// Credential keys are read from and written to the credential store.
+	Credential bool
+	// Check validates a parsed value.
+	Check func(value any) error
+	// Change, when set, runs before a new value is saved, e.g. to convert
+	// the data the key governs. value is nil when the key is unset.
+	Change func(ctx context.Context, value any) error
+}
+
+// ConfigKeys is the schema of the config file.
+var ConfigKeys = []ConfigKey{
+	{Name: "auth.token", Description: "API token (kept in the credential store)", Profile: true, Secret: true, Credential: true},
+	{Name: "auth.credentialHelper", Description: "Command that prints a token on stdout", Profile: true},
+	{Name: "auth.credentialHelperTTL", Description: "How long a helper's token is reused, e.g. 5m", Profile: true, Check: checkDuration},
+	{Name: "auth.checkAbilities", Description: "Check token abilities before calling the API", Kind: KindBool, Profile: true, Global: true},
+	{Name: "default.zoneId", Description: "Zone used when --zoneId is not given", Profile: true, Check: checkUlid},
+	{Name: "default.zoneName", Description: "Name of the default zone", Profile: true},
+	{Name: "apiUrl", Description: "Base URL of the Virak Cloud API", Profile: true, Check: checkURL},
+	{Name: "output", Description: "Default output format", Global: true, Check: checkOneOf(OutputFormats...)},
+	{Name: "retries", Description: "Retries of transient API failures", Kind: KindInt, Global: true, Check: checkNonNegative},
+	{Name: "rateLimit.requestsPerSecond", Description: "Sustained request rate", Kind: KindFloat, Profile: true, Global: true, Check: checkNonNegative},
+	{Name: "rateLimit.burst", Description: "Requests that may be sent back to back", Kind: KindInt, Profile: true, Global: true, Check: checkNonNegative},
+	{Name: "rateLimit.maxInFlight", Description: "Maximum concurrent requests", Kind: KindInt, Profile: true, Global: true, Check: checkNonNegative},
+	{Name: "credentials.store", Description: "Where tokens are kept: file or config", Global: true, Check: checkOneOf(CredentialStores...)},
+	{Name: "credentials.encrypt", Description: "Encrypt the credentials file with a passphrase", Kind: KindBool, Global: true, Change: changeEncryption},
+	{Name: "debug", Description: "Log API requests", Kind: KindBool, Global: true},
+	{Name: "trace", Description: "Log API requests with bodies", Kind: KindBool, Global: true},
+}
+
+// OutputFormats lists the values accepted by --output and the output config key.
+var OutputFormats = []string{"table", "json", "yaml", "csv", "tsv"}
+
+// LookupConfigKey finds a key of the schema. Names are case-insensitive, like
+// viper keys.
+func LookupConfigKey(name string) (ConfigKey, error) {
+	for _, key := range ConfigKeys {
+		if strings.EqualFold(key.Name, name) {
+			return key, nil
+		}
+	}
+	names := make([]string, len(ConfigKeys))
+	for i, key := range ConfigKeys {
+		names[i] = key.Name
+	}
+	return ConfigKey{}, fmt.Errorf("unknown config key %q; valid keys are: %s", name, strings.Join(names, ", "))
+}
+
+// Path returns where the key is stored for the active profile.
+func (k ConfigKey) Path() string {
+	if k.Profile {
+		return ProfileKey(ActiveProfile(), k.Name)
+	}
+	return k.Name
+}
+
+// Get returns the key's value for the active profile, as read from the config
+// file. Profile keys that may also be global fall back to the top-level value.
+func (k ConfigKey) Get() (value any, ok bool, err error) {
+	if k.Credential {
+		token, err := profileToken(ActiveProfile())
+		return token, token != "", err
+	}
+	if k.Profile {
+		if path := ProfileKey(ActiveProfile(), k.Name); viper.InConfig(path) {
+			return viper.Get(path), true, nil
+		}
+		if !k.Global {
+			return nil, false, nil
+		}
+	}
+	if viper.InConfig(k.Name) {
+		return viper.Get(k.Name), true, nil
+	}
+	return nil, false, nil
+}
+
+// Set stores value for the active profile and writes the config to disk.
+func (k ConfigKey) Set(value any) error {
+	if k.Credential {
+		return SaveToken(value.(string))
+	}
+	path := k.Path()
+	return EditConfig(func(settings map[string]any) error {
+		setPath(settings, path, value)
+		return nil
+	})
+}
+
+// Unset removes the key of the active profile from the config.
+func (k ConfigKey) Unset() error {
+	if k.Credential {
+		return DeleteToken(ActiveProfile())
+	}
+	path := k.Path()
+	return EditConfig(func(settings map[string]any) error {
+		deletePath(settings, path)
+		return nil
+	})
+}
+
+// Parse converts a command-line value to the key's kind and validates it.
+func (k ConfigKey) Parse(value string) (any, error) {
+	var parsed any
+	var err error
+	switch k.Kind {
+	case KindInt:
+		parsed, err = strconv.Atoi(value)
+	case KindFloat:
+		parsed, err = strconv.ParseFloat(value, 64)
+	case KindBool:
+		parsed, err = strconv.ParseBool(value)
+	default:
+		parsed = value
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid value %q", k.Name, value)
+	}
+	if err := k.check(parsed); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	return parsed, nil
+}
+
+// check validates a value read from the config file or parsed by Parse.
+func (k ConfigKey) check(value any) error {
+	ok := true
+	switch k.Kind {
+	case KindInt:
+		switch v := value.(type) {
+		case int, int64:
+		case float64:
+			ok = v == float64(int64(v))
+		default:
+			ok = false
+		}
+	case KindFloat:
+		switch value.(type) {
+		case int, int64, float64:
+		default:
+			ok = false
+		}
+	case KindBool:
+		_, ok = value.(bool)
+	default:
+		_, ok = value.(string)
+	}
+	if !ok {
+		return fmt.Errorf("invalid value %v", value)
+	}
+	if k.Check == nil {
+		return nil
+	}
+	return k.Check(value)
+}
+
+// ValidateConfig checks config file settings against the schema and returns
+// every problem found, sorted by key.
+func ValidateConfig(settings map[string]any) []error {
+	var errs []error
+	for key, value := range flattenSettings(settings, "") {
+		if err := validateSetting(key, value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
+
+func validateSetting(fullKey string, value any) error {
+	if fullKey == "activeprofile" {
+		name, _ := value.(string)
+		return ValidateProfileName(name)
+	}
+
+	key, global := fullKey, true
+	if rest, ok := strings.CutPrefix(key, "profiles."); ok {
+		name, k, found := strings.Cut(rest, ".")
+		if !found {
+			return fmt.Errorf("%s: profile must be a section", key)
+		}
+		if err := ValidateProfileName(name); err != nil {
+			return fmt.Errorf("%s: %w", fullKey, err)
+		}
+		key, global = k, false
+	}
+
+	schema, err := LookupConfigKey(key)
+	if err != nil || (global && !schema.Global) || (!global && !schema.Profile) {
+		return fmt.Errorf("%s: unknown config key", fullKey)
+	}
+	if value == nil {
+		return nil
+	}
+	if err := schema.check(value); err != nil {
+		return fmt.Errorf("%s: %w", fullKey, err)
+	}
+	return nil
+}
+
+// flattenSettings turns nested settings into dotted keys.
+func flattenSettings(settings map[string]any, prefix string) map[string]any {
+	out := map[string]any{}
+	for k, v := range settings {
+		if child, ok := v.(map[string]any); ok {
+			for ck, cv := range flattenSettings(child, prefix+k+".") {
+				out[ck] = cv
+			}
+			continue
+		}
+		out[prefix+k] = v
+	}
+	return out
+}
+
+func checkUlid(value any) error {
+	if s := value.(string); s != "" && !isValidUlid(s) {
+		return fmt.Errorf("must be a valid ULID")
+	}
+	return nil
+}
+
+func checkDuration(value any) error {
+	if s := value.(string); s != "" {
+		if d, err := time.ParseDuration(s); err != nil || d < 0 {
+			return fmt.Errorf("must be a duration such as 30s or 5m")
+		}
+	}
+	return nil
+}
+
+func checkURL(value any) error {
+	s := value.(string)
+	if s == "" {
+		return nil
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an http or https URL")
+	}
+	return nil
+}
+
+func checkNonNegative(value any) error {
+	var n float64
+	switch v := value.(type) {
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case float64:
+		n = v
+	}
+	if n < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
+
+func checkOneOf(allowed ...string) func(any) error {
+	return func(value any) error {
+		if s := value.(string); s != "" && !slices.Contains(allowed, s) {
+			return fmt.Errorf("must be one of: %s", strings.Join(allowed, ", "))
+		}
+		return nil
+	}
+}
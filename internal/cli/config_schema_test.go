@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookupConfigKey(t *testing.T) {
+	key, err := LookupConfigKey("RateLimit.Burst")
+	if err != nil || key.Name != "rateLimit.burst" {
+		t.Errorf("got %q, %v, want rateLimit.burst regardless of case", key.Name, err)
+	}
+	_, err = LookupConfigKey("zone")
+	if err == nil || !strings.HasPrefix(err.Error(), `unknown config key "zone"; valid keys are: auth.token, `) {
+		t.Errorf("got error %v, want an unknown key listing the valid ones", err)
+	}
+}
+
+func TestConfigKeyParse(t *testing.T) {
+	const zoneID = "01J9Y6ZQ5HZ0NE0000000000T1"
+	tests := []struct {
+		key   string
+		value string
+		want  any
+		err   string
+	}{
+		{"retries", "3", 3, ""},
+		{"retries", "0", 0, ""},
+		{"retries", "abc", nil, `retries: invalid value "abc"`},
+		{"retries", "1.5", nil, `retries: invalid value "1.5"`},
+		{"retries", "-1", nil, "retries: must not be negative"},
+		{"rateLimit.requestsPerSecond", "2.5", 2.5, ""},
+		{"rateLimit.requestsPerSecond", "fast", nil, `rateLimit.requestsPerSecond: invalid value "fast"`},
+		{"rateLimit.requestsPerSecond", "-0.5", nil, "rateLimit.requestsPerSecond: must not be negative"},
+		{"debug", "true", true, ""},
+		{"debug", "0", false, ""},
+		{"debug", "yes", nil, `debug: invalid value "yes"`},
+		{"output", "yaml", "yaml", ""},
+		{"output", "xml", nil, "output: must be one of: table, json, yaml, csv, tsv"},
+		{"default.zoneId", zoneID, zoneID, ""},
+		{"default.zoneId", "", "", ""},
+		{"default.zoneId", "tehran-1", nil, "default.zoneId: must be a valid ULID"},
+		{"apiUrl", "https://api.example.com/v1", "https://api.example.com/v1", ""},
+		{"apiUrl", "api.example.com", nil, "apiUrl: must be an http or https URL"},
+		{"apiUrl", "ftp://api.example.com", nil, "apiUrl: must be an http or https URL"},
+		{"auth.credentialHelperTTL", "90s", "90s", ""},
+		{"auth.credentialHelperTTL", "-5m", nil, "auth.credentialHelperTTL: must be a duration such as 30s or 5m"},
+		{"auth.credentialHelperTTL", "5", nil, "auth.credentialHelperTTL: must be a duration such as 30s or 5m"},
+		{"credentials.store", "file", "file", ""},
+		{"credentials.store", "keychain", nil, "credentials.store: must be one of: " + strings.Join(CredentialStores, ", ")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			key, err := LookupConfigKey(tt.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := key.Parse(tt.value)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("got %v, %v, want error %q", got, err, tt.err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %#v, %v, want %#v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]any
+		want     []string
+	}{
+		{
+			name: "valid",
+			settings: map[string]any{
+				"activeprofile": "staging",
+				"output":        "json",
+				// Values read from YAML or JSON
+				"retries":   float64(3),
+				"ratelimit": map[string]any{"burst": 5, "requestspersecond": 2},
+				"profiles": map[string]any{
+					"default": map[string]any{"auth": map[string]any{"credentialhelper": "pass virak"}},
+					"staging": map[string]any{
+						"apiurl":    "https://staging.example.com",
+						"default":   map[string]any{"zoneid": "01J9Y6ZQ5HZ0NE0000000000T1"},
+						"ratelimit": map[string]any{"burst": 1},
+					},
+				},
+				"debug": nil,
+			},
+		},
+		{
+			name: "unknown keys",
+			settings: map[string]any{
+				"zone":    "tehran",
+				"apiurl":  "https://api.example.com",
+				"default": map[string]any{"zoneid": "01J9Y6ZQ5HZ0NE0000000000T1"},
+				"profiles": map[string]any{
+					"default": map[string]any{"output": "json", "colour": true},
+				},
+			},
+			want: []string{
+				"apiurl: unknown config key",
+				"default.zoneid: unknown config key",
+				"profiles.default.colour: unknown config key",
+				"profiles.default.output: unknown config key",
+				"zone: unknown config key",
+			},
+		},
+		{
+			name: "invalid values",
+			settings: map[string]any{
+				"retries":     1.5,
+				"debug":       "yes",
+				"output":      "xml",
+				"credentials": map[string]any{"encrypt": 1},
+				"profiles": map[string]any{
+					"default": map[string]any{
+						"default":   map[string]any{"zoneid": "tehran-1"},
+						"ratelimit": map[string]any{"requestspersecond": -1},
+						"auth":      map[string]any{"credentialhelperttl": "soon"},
+					},
+				},
+			},
+			want: []string{
+				"credentials.encrypt: invalid value 1",
+				"debug: invalid value yes",
+				"output: must be one of: table, json, yaml, csv, tsv",
+				"profiles.default.auth.credentialhelperttl: must be a duration such as 30s or 5m",
+				"profiles.default.default.zoneid: must be a valid ULID",
+				"profiles.default.ratelimit.requestspersecond: must not be negative",
+				"retries: invalid value 1.5",
+			},
+		},
+		{
+			name: "profiles",
+			settings: map[string]any{
+				"activeprofile": "Prod!",
+				"profiles": map[string]any{
+					"flat":    "token",
+					"My Prod": map[string]any{"apiurl": "https://api.example.com"},
+				},
+			},
+			want: []string{
+				`invalid profile name "Prod!": use lower-case letters, digits, '-' and '_'`,
+				"profiles.My Prod.apiurl: invalid profile name \"My Prod\": use lower-case letters, digits, '-' and '_'",
+				"profiles.flat: profile must be a section",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateConfig(tt.settings)
+			got := make([]string, len(errs))
+			for i, err := range errs {
+				got[i] = err.Error()
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("got problems:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// Target is something a destructive command acts on, shown to the user before
+// they confirm.
+type Target struct {
+	// Kind names the target, e.g. "IPv4 firewall rule".
+	Kind string
+	ID   string
+	Name string
+	// lookup, when set, is listed to find Name.
+	lookup *Resource
+}
+
+// Target returns the target r with id, whose name Confirm looks up.
+func (r Resource) Target(id string) Target {
+	return Target{Kind: r.Kind, ID: id, lookup: &r}
+}
+
+func (t Target) String() string {
+	switch {
+	case t.Name != "" && t.ID != "":
+		return fmt.Sprintf("%s %s (%s)", t.Kind, t.Name, t.ID)
+	case t.Name != "":
+		return t.Kind + " " + t.Name
+	}
+	return t.Kind + " " + t.ID
+}
+
+// DryRun reports whether --dry-run is set: requests that change something are
+// printed instead of sent.
+func DryRun() bool {
+	return viper.GetBool("dryRun")
+}
+
+// Confirm asks the user to confirm that action, e.g. "delete", may be applied
+// to targets, which it lists first. It returns true without asking with --yes,
+// and with --dry-run, which sends nothing. Without a terminal on stdin there
+// is nobody to ask, so it fails unless --yes is set. When the user declines,
+// it prints "Aborted." and returns false with no error, so commands can
+// return the error either way:
+//
+//	if ok, err := cli.Confirm(ctx, "delete", cli.Network.Target(id)); !ok {
+//		return err
+//	}
+func Confirm(ctx context.Context, action string, targets ...Target) (bool, error) {
+	if viper.GetBool("yes") || DryRun() {
+		return true, nil
+	}
+	what := make([]string, len(targets))
+	for i, t := range targets {
+		what[i] = t.String()
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, UsageError(fmt.Errorf("refusing to %s %s without confirmation because stdin is not a terminal; pass --yes to confirm", action, strings.Join(what, ", ")))
+	}
+
+	for i, t := range targets {
+		if t.lookup != nil && t.Name == "" {
+			what[i] = describe(ctx, t)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "This will %s:\n", action)
+	for _, w := range what {
+		fmt.Fprintf(os.Stderr, "  - %s\n", w)
+	}
+	fmt.Fprint(os.Stderr, "Continue? [y/N]: ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	fmt.Fprintln(os.Stderr, "Aborted.")
+	return false, nil
+}
+
+// describe returns t with its name, looked up in the zone of ctx. Without it
+// the ID still tells what is affected.
+func describe(ctx context.Context, t Target) string {
+	client := http.NewClient(TokenFromContext(ctx), ClientOptions()...)
+	all, err := t.lookup.list(ctx, client, ZoneIDFromContext(ctx))
+	if err != nil {
+		slog.Warn("failed to look up name", "kind", t.Kind, "id", t.ID, "error", err)
+		return t.String()
+	}
+	for _, r := range all {
+		if r.ID == t.ID {
+			t.Name = r.Name
+			break
+		}
+	}
+	return t.String()
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+// pipeStdin makes stdin a pipe, which is not a terminal, for the test.
+func pipeStdin(t *testing.T) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	stdin := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() {
+		os.Stdin = stdin
+		r.Close()
+	})
+}
+
+func TestConfirmWithoutTerminal(t *testing.T) {
+	pipeStdin(t)
+	t.Cleanup(viper.Reset)
+
+	targets := []Target{{Kind: "network", ID: "01J9Y6ZQ5HNETW0RK00000000A", Name: "web"}, Network.Target("01J9Y6ZQ5HNETW0RK00000000B")}
+	ok, err := Confirm(context.Background(), "delete", targets...)
+	if ok || err == nil {
+		t.Fatalf("got %t, %v, want a refusal", ok, err)
+	}
+	if code := ExitCode(err); code != ExitUsage {
+		t.Errorf("got exit code %d, want %d", code, ExitUsage)
+	}
+	want := "refusing to delete network web (01J9Y6ZQ5HNETW0RK00000000A), network 01J9Y6ZQ5HNETW0RK00000000B without confirmation"
+	if !strings.HasPrefix(err.Error(), want) || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("got error %q, want it to start with %q and mention --yes", err, want)
+	}
+
+	for _, key := range []string{"yes", "dryRun"} {
+		viper.Reset()
+		viper.Set(key, true)
+		if ok, err := Confirm(context.Background(), "delete", targets...); !ok || err != nil {
+			t.Errorf("with %s got %t, %v, want true without asking", key, ok, err)
+		}
+	}
+}
+
+func TestTargetString(t *testing.T) {
+	tests := []struct {
+		target Target
+		want   string
+	}{
+		{Target{Kind: "instance", ID: "01J9Y6ZQ5H00000000000000AA", Name: "web"}, "instance web (01J9Y6ZQ5H00000000000000AA)"},
+		{Target{Kind: "domain", Name: "example.com"}, "domain example.com"},
+		{Instance.Target("01J9Y6ZQ5H00000000000000AA"), "instance 01J9Y6ZQ5H00000000000000AA"},
+	}
+	for _, tt := range tests {
+		if got := tt.target.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	_, url, ids := fakeNetworks(t, "web")
+	viper.Set("apiUrl", url)
+	t.Cleanup(viper.Reset)
+	ctx := context.WithValue(context.WithValue(context.Background(), ctxTokenKey, "token"), ctxZoneIDKey, fake.DefaultZoneID)
+
+	if got, want := describe(ctx, Network.Target(ids["web"])), "network web ("+ids["web"]+")"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	// Without the name the ID still tells what is affected
+	const unknownID = "01J9Y6ZQ5H00000000000000ZZ"
+	if got, want := describe(ctx, Network.Target(unknownID)), "network "+unknownID; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	viper.Set("apiUrl", "http://127.0.0.1:1")
+	viper.Set("retries", 0)
+	if got, want := describe(ctx, Network.Target(ids["web"])), "network "+ids["web"]; got != want {
+		t.Errorf("got %q when the lookup fails, want %q", got, want)
+	}
+}
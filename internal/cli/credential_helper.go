@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultCredentialHelperTTL is how long a token printed by a credential helper
+// is reused when auth.credentialHelperTTL is not set.
+const DefaultCredentialHelperTTL = 5 * time.Minute
+
+// credentialHelperTimeout bounds a single run of a credential helper.
+const credentialHelperTimeout = time.Minute
+
+// credentialHelper runs the command configured in auth.credentialHelper and
+// caches its token in memory for ttl, so a command that sends many requests,
+// e.g. with --wait, runs the helper again only once the token is due to
+// expire. Tokens from helpers are never written to disk.
+type credentialHelper struct {
+	command string
+	profile string
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+var (
+	helpersMu sync.Mutex
+	helpers   = map[string]*credentialHelper{}
+)
+
+// activeCredentialHelper returns the credential helper of the active profile,
+// or nil when none is configured.
+func activeCredentialHelper() (*credentialHelper, error) {
+	command := strings.TrimSpace(ProfileString("auth.credentialHelper"))
+	if command == "" {
+		return nil, nil
+	}
+	ttl := DefaultCredentialHelperTTL
+	if value := ProfileString("auth.credentialHelperTTL"); value != "" {
+		var err error
+		if ttl, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid auth.credentialHelperTTL %q: %w", value, err)
+		}
+	}
+
+	profile := ActiveProfile()
+	helpersMu.Lock()
+	defer helpersMu.Unlock()
+	key := profile + "\x00" + command
+	h, ok := helpers[key]
+	if !ok || h.ttl != ttl {
+		h = &credentialHelper{command: command, profile: profile, ttl: ttl}
+		helpers[key] = h
+	}
+	return h, nil
+}
+
+// Token returns the cached token, running the helper when there is none, it
+// has expired or refresh is set.
+func (h *credentialHelper) Token(ctx context.Context, refresh bool) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !refresh && h.token != "" && time.Now().Before(h.expires) {
+		return h.token, nil
+	}
+
+	token, err := h.run(ctx)
+	if err != nil {
+		return "", err
+	}
+	h.token, h.expires = token, time.Now().Add(h.ttl)
+	return token, nil
+}
+
+// run executes the helper through the shell, like git credential helpers. The
+// helper's stderr is passed through so it can prompt or report errors; the
+// first line of stdout is the token.
+func (h *credentialHelper) run(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialHelperTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", h.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", h.command)
+	}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "VIRAK_PROFILE="+h.profile)
+
+	start := time.Now()
+	if err := cmd.Run(); err != nil {
+		slog.Error("credential helper failed", "profile", h.profile, "error", err)
+		return "", fmt.Errorf("credential helper %q failed: %w", h.command, err)
+	}
+	token, _, _ := strings.Cut(stdout.String(), "\n")
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", errors.New("credential helper printed no token")
+	}
+	slog.Debug("credential helper returned a token", "profile", h.profile, "duration", time.Since(start))
+	return token, nil
+}
+
+// tokenRefresher returns a function that asks the active profile's credential
+// helper for a new token, or nil when the token does not come from a helper.
+func tokenRefresher() func(ctx context.Context) (string, error) {
+	if viper.GetString("token") != "" {
+		return nil
+	}
+	h, err := activeCredentialHelper()
+	if err != nil || h == nil {
+		return nil
+	}
+	return func(ctx context.Context) (string, error) {
+		return h.Token(ctx, true)
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// countingHelper configures a credential helper for the default profile that
+// prints token-1, token-2 and so on, one more on every run.
+func countingHelper(t *testing.T, ttl string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the helper is a POSIX shell command")
+	}
+	t.Cleanup(viper.Reset)
+	runs := filepath.Join(t.TempDir(), "runs")
+	viper.Set(ProfileKey(DefaultProfile, "auth.credentialHelper"), "echo run >> '"+runs+"'; echo token-$(wc -l < '"+runs+"' | tr -d ' ')")
+	viper.Set(ProfileKey(DefaultProfile, "auth.credentialHelperTTL"), ttl)
+}
+
+func helperToken(t *testing.T, refresh bool) string {
+	t.Helper()
+	h, err := activeCredentialHelper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := h.Token(context.Background(), refresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestCredentialHelperTTL(t *testing.T) {
+	countingHelper(t, "200ms")
+
+	if got := helperToken(t, false); got != "token-1" {
+		t.Fatalf("got %s, want token-1", got)
+	}
+	if got := helperToken(t, false); got != "token-1" {
+		t.Errorf("got %s within the TTL, want the cached token-1", got)
+	}
+	time.Sleep(250 * time.Millisecond)
+	if got := helperToken(t, false); got != "token-2" {
+		t.Errorf("got %s after the TTL, want token-2 from a new run", got)
+	}
+	if got := helperToken(t, true); got != "token-3" {
+		t.Errorf("got %s when refreshing, want token-3 from a new run", got)
+	}
+}
+
+func TestCredentialHelperDefaultTTL(t *testing.T) {
+	countingHelper(t, "")
+
+	h, err := activeCredentialHelper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ttl != DefaultCredentialHelperTTL {
+		t.Errorf("got TTL %s, want %s", h.ttl, DefaultCredentialHelperTTL)
+	}
+
+	viper.Set(ProfileKey(DefaultProfile, "auth.credentialHelperTTL"), "soon")
+	if _, err := activeCredentialHelper(); err == nil {
+		t.Error("got no error for an invalid TTL")
+	}
+}
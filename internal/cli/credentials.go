@@ -0,0 +1,308 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+
+	"github.com/virak-cloud/cli/internal/credentials"
+)
+
+// CredentialStores lists the values accepted by the credentials.store key.
+var CredentialStores = []string{"file", "config"}
+
+// CredentialStore returns the token store selected by the credentials.store
+// key. The default keeps tokens in ~/.virak-cli/credentials.json, encrypted
+// when credentials.encrypt is set.
+func CredentialStore() (credentials.Store, error) {
+	switch store := viper.GetString("credentials.store"); store {
+	case "", "file":
+		path, err := credentials.DefaultPath()
+		if err != nil {
+			return nil, err
+		}
+		return &credentials.FileStore{
+			Path:          path,
+			Encrypt:       viper.GetBool("credentials.encrypt"),
+			Passphrase:    readPassphrase,
+			NewPassphrase: readNewPassphrase,
+		}, nil
+	case "config":
+		return configStore{}, nil
+	default:
+		return nil, fmt.Errorf("unknown credentials.store %q; use file or config", store)
+	}
+}
+
+var (
+	passphraseOnce sync.Once
+	passphrase     []byte
+	passphraseErr  error
+)
+
+// readPassphrase returns the passphrase of encrypted credentials from
+// VIRAK_CREDENTIALS_PASSPHRASE, or asks for it when stdin is a terminal.
+func readPassphrase() ([]byte, error) {
+	passphraseOnce.Do(func() {
+		passphrase, passphraseErr = promptPassphrase(false)
+	})
+	return passphrase, passphraseErr
+}
+
+// readNewPassphrase is readPassphrase for credentials that are about to be
+// encrypted for the first time: the passphrase is asked for twice, so a typo
+// does not lock the user out.
+func readNewPassphrase() ([]byte, error) {
+	passphraseOnce.Do(func() {
+		passphrase, passphraseErr = promptPassphrase(true)
+	})
+	return passphrase, passphraseErr
+}
+
+func promptPassphrase(confirm bool) ([]byte, error) {
+	if env := os.Getenv("VIRAK_CREDENTIALS_PASSPHRASE"); env != "" {
+		return []byte(env), nil
+	}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, errors.New("credentials are encrypted: set VIRAK_CREDENTIALS_PASSPHRASE or run in a terminal")
+	}
+	prompt := "Credentials passphrase: "
+	if confirm {
+		prompt = "New credentials passphrase: "
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	first, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	if len(first) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	if !confirm {
+		return first, nil
+	}
+	fmt.Fprint(os.Stderr, "Repeat passphrase: ")
+	second, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(first, second) {
+		return nil, errors.New("passphrases do not match")
+	}
+	return first, nil
+}
+
+// changeEncryption rewrites the credentials file when credentials.encrypt is
+// set to value, so what is on disk follows the setting. Tokens are only
+// written in plain text again after the user confirms it.
+func changeEncryption(ctx context.Context, value any) error {
+	encrypt, _ := value.(bool)
+	store, err := CredentialStore()
+	if err != nil {
+		return err
+	}
+	file, ok := store.(*credentials.FileStore)
+	if !ok {
+		return nil
+	}
+	encrypted, err := file.Encrypted()
+	if err != nil {
+		return err
+	}
+	if encrypted == encrypt {
+		return nil
+	}
+	if !encrypt {
+		target := Target{Kind: "credentials file", ID: file.Path}
+		if ok, err := Confirm(ctx, "decrypt the tokens and store them in plain text in", target); !ok {
+			if err == nil {
+				err = errors.New("credentials.encrypt not changed")
+			}
+			return err
+		}
+	}
+	file.Encrypt = encrypt
+	if err := file.Rewrite(); err != nil {
+		return fmt.Errorf("failed to rewrite %s: %w", file.Path, err)
+	}
+	return nil
+}
+
+// configStore keeps tokens in plain text in the profile's auth.token key, as
+// earlier versions did.
+type configStore struct{}
+
+func (configStore) Name() string { return "config" }
+
+func (configStore) Get(profile string) (string, error) {
+	return viper.GetString(ProfileKey(profile, "auth.token")), nil
+}
+
+func (s configStore) Has(profile string) (bool, error) {
+	token, err := s.Get(profile)
+	return token != "", err
+}
+
+func (configStore) Set(profile, token string) error {
+	return EditConfig(func(settings map[string]any) error {
+		setPath(settings, ProfileKey(profile, "auth.token"), token)
+		return nil
+	})
+}
+
+// Delete clears the token but keeps the key so the profile continues to exist.
+func (s configStore) Delete(profile string) error {
+	return s.Set(profile, "")
+}
+
+// profileToken returns the token saved for profile: from the credential store,
+// or from a plaintext auth.token left in the config by earlier versions, which
+// is moved into the store as it is read.
+func profileToken(profile string) (string, error) {
+	store, err := CredentialStore()
+	if err != nil {
+		return "", err
+	}
+	token, err := storedToken(store, profile)
+	if err != nil || token == "" {
+		return token, err
+	}
+	if _, ok := store.(configStore); !ok && token == viper.GetString(ProfileKey(profile, "auth.token")) {
+		moveConfigToken(store, profile, token)
+	}
+	return token, nil
+}
+
+// moveConfigToken moves the plaintext token of profile from the config into
+// store. When that fails, e.g. because an encrypted store cannot ask for its
+// passphrase, the token stays in the config and keeps working.
+func moveConfigToken(store credentials.Store, profile, token string) {
+	if err := store.Set(profile, token); err != nil {
+		slog.Warn("failed to move the token from the config to the credential store", "profile", profile, "error", err)
+		return
+	}
+	if err := (configStore{}).Delete(profile); err != nil {
+		slog.Warn("failed to remove the token from the config", "profile", profile, "error", err)
+		return
+	}
+	slog.Info("moved the token from the config to the credential store", "profile", profile, "store", store.Name())
+	fmt.Fprintf(os.Stderr, "Moved the token of profile %q from %s to the credential store.\n", profile, ConfigFile())
+}
+
+// errNeedsInput is returned instead of asking the user for something where
+// nobody may be there to answer.
+var errNeedsInput = errors.New("reading the token needs user input")
+
+// tokenWithoutPrompt is Token for shell completion, which hangs the shell while
+// waiting for input. It fails with errNeedsInput instead of running a
+// credential helper, which may prompt, or asking for the passphrase of
+// encrypted credentials when VIRAK_CREDENTIALS_PASSPHRASE is not set.
+func tokenWithoutPrompt() (string, error) {
+	if token := viper.GetString("token"); token != "" {
+		return token, nil
+	}
+	if ProfileString("auth.credentialHelper") != "" {
+		return "", errNeedsInput
+	}
+	store, err := CredentialStore()
+	if err != nil {
+		return "", err
+	}
+	if file, ok := store.(*credentials.FileStore); ok {
+		envPassphrase := func() ([]byte, error) {
+			if env := os.Getenv("VIRAK_CREDENTIALS_PASSPHRASE"); env != "" {
+				return []byte(env), nil
+			}
+			return nil, errNeedsInput
+		}
+		file.Passphrase, file.NewPassphrase = envPassphrase, envPassphrase
+	}
+	return storedToken(store, ActiveProfile())
+}
+
+func storedToken(store credentials.Store, profile string) (string, error) {
+	token, err := store.Get(profile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token of profile %q: %w", profile, err)
+	}
+	if token != "" {
+		return token, nil
+	}
+	return viper.GetString(ProfileKey(profile, "auth.token")), nil
+}
+
+// SaveToken stores the token of the active profile. Outside the config store,
+// any plaintext token left in the config is cleared.
+func SaveToken(token string) error {
+	profile := ActiveProfile()
+	store, err := CredentialStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Set(profile, token); err != nil {
+		return err
+	}
+	if _, ok := store.(configStore); ok {
+		return nil
+	}
+	// The empty key keeps the profile section in the config
+	return SetProfileValues(map[string]any{"auth.token": ""})
+}
+
+// DeleteToken removes the token of profile from the credential store and the
+// config.
+func DeleteToken(profile string) error {
+	store, err := CredentialStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Delete(profile); err != nil {
+		return err
+	}
+	if viper.GetString(ProfileKey(profile, "auth.token")) == "" {
+		return nil
+	}
+	return configStore{}.Delete(profile)
+}
+
+// HasToken reports whether a token is saved for profile without decrypting
+// the credential store.
+func HasToken(profile string) bool {
+	if viper.GetString(ProfileKey(profile, "auth.token")) != "" {
+		return true
+	}
+	store, err := CredentialStore()
+	if err != nil {
+		return false
+	}
+	ok, err := store.Has(profile)
+	if err != nil {
+		slog.Warn("failed to read credential store", "profile", profile, "error", err)
+	}
+	return ok
+}
+
+// WarnInsecureConfig prints a warning when the config file can be read by
+// other users of the machine.
+func WarnInsecureConfig() {
+	if runtime.GOOS == "windows" {
+		return
+	}
+	path := ConfigFile()
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm()&0o004 == 0 {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %s is readable by other users. Run 'chmod 600 %s' to protect it.\n", path, path)
+}
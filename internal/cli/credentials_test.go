@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/internal/credentials"
+)
+
+// tempHome points the home directory, and so the config and credentials
+// files, at a new directory for the test.
+func tempHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Cleanup(viper.Reset)
+	// The passphrase is read once per run of the CLI
+	passphraseOnce, passphrase, passphraseErr = sync.Once{}, nil, nil
+	return home
+}
+
+func TestChangeEncryption(t *testing.T) {
+	tempHome(t)
+	t.Setenv("VIRAK_CREDENTIALS_PASSPHRASE", "pass")
+	pipeStdin(t)
+	path, err := credentials.DefaultPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&credentials.FileStore{Path: path}).Set("default", "secret-token"); err != nil {
+		t.Fatal(err)
+	}
+	encrypted := func() bool {
+		t.Helper()
+		ok, err := (&credentials.FileStore{Path: path}).Encrypted()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+	ctx := context.Background()
+
+	if err := changeEncryption(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if !encrypted() || strings.Contains(string(data), "secret-token") {
+		t.Fatalf("got file:\n%s\nwant the token encrypted", data)
+	}
+	// Nothing to do when the file already matches
+	if err := changeEncryption(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Decrypting needs a confirmation, which a script gives with --yes
+	err = changeEncryption(ctx, false)
+	if ExitCode(err) != ExitUsage || !strings.Contains(err.Error(), "decrypt the tokens and store them in plain text in credentials file "+path) {
+		t.Errorf("got error %v, want a refusal without --yes", err)
+	}
+	if !encrypted() {
+		t.Fatal("the file was decrypted without confirmation")
+	}
+	viper.Set("yes", true)
+	if err := changeEncryption(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if encrypted() {
+		t.Error("the file is still encrypted")
+	}
+	if token, err := (&credentials.FileStore{Path: path}).Get("default"); err != nil || token != "secret-token" {
+		t.Errorf("got %q, %v, want secret-token", token, err)
+	}
+
+	// The config store has no file to rewrite
+	viper.Set("credentials.store", "config")
+	if err := changeEncryption(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	if encrypted() {
+		t.Error("the file was encrypted although the config store is used")
+	}
+}
+
+// legacyConfig writes a config with a plaintext token for the default profile
+// and loads it.
+func legacyConfig(t *testing.T, home string) string {
+	t.Helper()
+	path := filepath.Join(home, ".virak-cli.yaml")
+	config := "activeprofile: default\nprofiles:\n  default:\n    auth:\n      token: legacy-token\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestProfileTokenMovesConfigToken(t *testing.T) {
+	home := tempHome(t)
+	config := legacyConfig(t, home)
+
+	token, err := profileToken(DefaultProfile)
+	if err != nil || token != "legacy-token" {
+		t.Fatalf("got %q, %v, want legacy-token", token, err)
+	}
+	data, _ := os.ReadFile(config)
+	if strings.Contains(string(data), "legacy-token") || viper.GetString(ProfileKey(DefaultProfile, "auth.token")) != "" {
+		t.Errorf("the token is still in the config:\n%s", data)
+	}
+	if !strings.Contains(string(data), "default") {
+		t.Errorf("the profile was removed from the config:\n%s", data)
+	}
+	path, _ := credentials.DefaultPath()
+	if token, err := (&credentials.FileStore{Path: path}).Get(DefaultProfile); err != nil || token != "legacy-token" {
+		t.Errorf("got %q, %v from the credential store, want legacy-token", token, err)
+	}
+	if token, err := profileToken(DefaultProfile); err != nil || token != "legacy-token" {
+		t.Errorf("got %q, %v after the move, want legacy-token", token, err)
+	}
+}
+
+func TestProfileTokenKeepsConfigTokenWhenTheStoreFails(t *testing.T) {
+	home := tempHome(t)
+	config := legacyConfig(t, home)
+	pipeStdin(t)
+	// The new encrypted store has no passphrase to encrypt with
+	t.Setenv("VIRAK_CREDENTIALS_PASSPHRASE", "")
+	viper.Set("credentials.encrypt", true)
+
+	token, err := profileToken(DefaultProfile)
+	if err != nil || token != "legacy-token" {
+		t.Fatalf("got %q, %v, want legacy-token", token, err)
+	}
+	if data, _ := os.ReadFile(config); !strings.Contains(string(data), "legacy-token") {
+		t.Errorf("the token was removed from the config although the store failed:\n%s", data)
+	}
+
+	viper.Set("credentials.store", "config")
+	if token, err := profileToken(DefaultProfile); err != nil || token != "legacy-token" {
+		t.Errorf("got %q, %v from the config store, want legacy-token", token, err)
+	}
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// Exit codes of virak-cli. Scripts rely on them, so a code must keep its
+// meaning; they are documented in the README.
+const (
+	ExitOK = 0
+	// ExitFailure is any failure without a more specific code, e.g. the API
+	// being unreachable or answering with a server error.
+	ExitFailure = 1
+	// ExitUsage means the command line is wrong: an unknown command or flag,
+	// or a missing or invalid flag value.
+	ExitUsage = 2
+	// ExitAuth means the CLI is not logged in, or the token is invalid or not
+	// allowed to do what was asked.
+	ExitAuth = 3
+	// ExitNotFound means the resource does not exist.
+	ExitNotFound = 4
+	// ExitValidation means the API rejected the values of the request.
+	ExitValidation = 5
+	// ExitConflict means the request conflicts with the state of the resource,
+	// e.g. deleting a network instances are still connected to.
+	ExitConflict = 6
+	// ExitTimeout means a request or --wait timed out.
+	ExitTimeout = 7
+	// ExitPartialFailure means some of the items a command acts on failed.
+	ExitPartialFailure = 8
+)
+
+// errorKinds name the exit codes in machine-readable error output.
+var errorKinds = map[int]string{
+	ExitFailure:        "error",
+	ExitUsage:          "usage",
+	ExitAuth:           "auth",
+	ExitNotFound:       "not_found",
+	ExitValidation:     "validation",
+	ExitConflict:       "conflict",
+	ExitTimeout:        "timeout",
+	ExitPartialFailure: "partial_failure",
+}
+
+// ErrorKind returns the name of exit code, e.g. "not_found".
+func ErrorKind(code int) string {
+	if kind, ok := errorKinds[code]; ok {
+		return kind
+	}
+	return errorKinds[ExitFailure]
+}
+
+// exitError is an error with the exit code it ends the CLI with.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitError{code: code, err: err}
+}
+
+// UsageError marks err as a mistake on the command line.
+func UsageError(err error) error { return withExitCode(ExitUsage, err) }
+
+// AuthError marks err as a missing login or insufficient token.
+func AuthError(err error) error { return withExitCode(ExitAuth, err) }
+
+// NotFoundError marks err as a resource that does not exist.
+func NotFoundError(err error) error { return withExitCode(ExitNotFound, err) }
+
+// PartialFailure marks err as the failure of some, but not all, of the items
+// a command acts on.
+func PartialFailure(err error) error { return withExitCode(ExitPartialFailure, err) }
+
+// cobraUsageErrors start the messages of the usage errors cobra returns
+// without a FlagErrorFunc or Args function that could mark them.
+var cobraUsageErrors = []string{
+	"unknown command",
+	"required flag(s)",
+	"if any flags in the group",
+	"at least one of the flags in the group",
+}
+
+// ExitCode returns the code the CLI exits with when a command fails with err.
+// Errors marked with UsageError and the like keep their code; API errors get
+// theirs from the HTTP status.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var coded *exitError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	if apiErr, ok := http.AsAPIError(err); ok {
+		switch apiErr.StatusCode {
+		case 400, 422:
+			return ExitValidation
+		case 401, 403:
+			return ExitAuth
+		case 404:
+			return ExitNotFound
+		case 408, 504:
+			return ExitTimeout
+		case 409:
+			return ExitConflict
+		}
+		return ExitFailure
+	}
+	var netErr net.Error
+	if errors.Is(err, http.ErrWaitTimeout) || errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ExitTimeout
+	}
+	for _, prefix := range cobraUsageErrors {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return ExitUsage
+		}
+	}
+	return ExitFailure
+}
+
+// MarkUsageErrors makes the flag and argument errors of cmd and its
+// subcommands usage errors, see ExitCode. Cobra only rejects unknown commands
+// given to the root; command groups such as "zone" print their help instead,
+// so they are given a RunE that rejects them too.
+func MarkUsageErrors(cmd *cobra.Command) {
+	if !cmd.HasParent() {
+		cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+			return UsageError(err)
+		})
+	} else if cmd.HasSubCommands() && !cmd.Runnable() {
+		cmd.RunE = unknownSubcommand
+	}
+	if args := cmd.Args; args != nil {
+		cmd.Args = func(cmd *cobra.Command, a []string) error {
+			return UsageError(args(cmd, a))
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		MarkUsageErrors(sub)
+	}
+}
+
+// unknownSubcommand runs a command group: it shows the group's help, or fails
+// like cobra does for the root when given an unknown command.
+func unknownSubcommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmd.Help()
+	}
+	msg := fmt.Sprintf("unknown command %q for %q", args[0], cmd.CommandPath())
+	if cmd.SuggestionsMinimumDistance <= 0 {
+		// Cobra's default for the root
+		cmd.SuggestionsMinimumDistance = 2
+	}
+	if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+		msg += "\n\nDid you mean this?\n\t" + strings.Join(suggestions, "\n\t")
+	}
+	return UsageError(errors.New(msg))
+}
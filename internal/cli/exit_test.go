@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestExitCode(t *testing.T) {
+	apiError := func(status int) error {
+		return fmt.Errorf("failed to delete network: %w", &http.APIError{StatusCode: status, Method: "DELETE", URL: "/network"})
+	}
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"plain", errors.New("boom"), ExitFailure},
+		{"usage", UsageError(errors.New("bad flag")), ExitUsage},
+		{"auth", AuthError(errors.New("not logged in")), ExitAuth},
+		{"not found", NotFoundError(errors.New("no network named web")), ExitNotFound},
+		{"partial failure", PartialFailure(errors.New("1 of 3 failed")), ExitPartialFailure},
+		{"wrapped usage", fmt.Errorf("--networkId: %w", UsageError(errors.New("ambiguous"))), ExitUsage},
+		{"marked API error", UsageError(apiError(404)), ExitUsage},
+		{"400", apiError(400), ExitValidation},
+		{"422", apiError(422), ExitValidation},
+		{"401", apiError(401), ExitAuth},
+		{"403", apiError(403), ExitAuth},
+		{"404", apiError(404), ExitNotFound},
+		{"408", apiError(408), ExitTimeout},
+		{"504", apiError(504), ExitTimeout},
+		{"409", apiError(409), ExitConflict},
+		{"500", apiError(500), ExitFailure},
+		{"429", apiError(429), ExitFailure},
+		{"wait timeout", fmt.Errorf("network web: %w", http.ErrWaitTimeout), ExitTimeout},
+		{"deadline", fmt.Errorf("request: %w", context.DeadlineExceeded), ExitTimeout},
+		{"net timeout", fmt.Errorf("dial: %w", timeoutError{}), ExitTimeout},
+		{"canceled", context.Canceled, ExitFailure},
+		{"unknown command", errors.New(`unknown command "lst" for "virak-cli zone"`), ExitUsage},
+		{"required flag", errors.New(`required flag(s) "name" not set`), ExitUsage},
+		{"flag group", errors.New("if any flags in the group [record replay] are set none of the others can be"), ExitUsage},
+		{"one of group", errors.New("at least one of the flags in the group [name id] is required"), ExitUsage},
+		{"prefix elsewhere", errors.New(`failed: unknown command "x"`), ExitFailure},
+	}
+	for _, tt := range tests {
+		if got := ExitCode(tt.err); got != tt.want {
+			t.Errorf("%s: ExitCode(%v) = %d, want %d", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	tests := map[int]string{
+		ExitFailure:        "error",
+		ExitUsage:          "usage",
+		ExitAuth:           "auth",
+		ExitNotFound:       "not_found",
+		ExitValidation:     "validation",
+		ExitConflict:       "conflict",
+		ExitTimeout:        "timeout",
+		ExitPartialFailure: "partial_failure",
+		42:                 "error",
+	}
+	for code, want := range tests {
+		if got := ErrorKind(code); got != want {
+			t.Errorf("ErrorKind(%d) = %s, want %s", code, got, want)
+		}
+	}
+}
+
+func TestMarkUsageErrors(t *testing.T) {
+	root := &cobra.Command{Use: "virak-cli", SilenceErrors: true, SilenceUsage: true}
+	show := &cobra.Command{Use: "show", Args: cobra.ExactArgs(1), RunE: func(*cobra.Command, []string) error { return nil }}
+	create := &cobra.Command{Use: "create", RunE: func(*cobra.Command, []string) error { return nil }}
+	create.Flags().String("name", "", "")
+	_ = create.MarkFlagRequired("name")
+	group := &cobra.Command{Use: "zone"}
+	group.AddCommand(&cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error { return nil }})
+	root.AddCommand(show, create, group)
+	MarkUsageErrors(root)
+
+	for _, args := range [][]string{
+		{"show"},
+		{"show", "a", "b"},
+		{"show", "a", "--bogus"},
+		{"create"},
+		{"create", "--name"},
+		{"lst"},
+		{"zone", "lst"},
+	} {
+		root.SetArgs(args)
+		err := root.Execute()
+		if code := ExitCode(err); code != ExitUsage {
+			t.Errorf("%v: got exit code %d for %v, want %d", args, code, err, ExitUsage)
+		}
+	}
+
+	root.SetArgs([]string{"show", "a"})
+	if err := root.Execute(); err != nil {
+		t.Errorf("got error %v, want the wrapped Args to pass valid arguments", err)
+	}
+
+	root.SetArgs([]string{"zone", "lst"})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "Did you mean this?\n\tlist") {
+		t.Errorf("got error %v, want the unknown command with a suggestion", err)
+	}
+	root.SetArgs([]string{"zone"})
+	root.SetOut(io.Discard)
+	if err := root.Execute(); err != nil {
+		t.Errorf("got error %v, want the group's help", err)
+	}
+}
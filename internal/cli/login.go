@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// LoginCallback is a loopback HTTP listener that receives the token created in
+// the browser. The panel redirects to RedirectURL with the token and the state
+// in the query string.
+type LoginCallback struct {
+	listener net.Listener
+	server   *http.Server
+	state    string
+	tokens   chan string
+}
+
+// StartLoginCallback listens on a random port of 127.0.0.1.
+func StartLoginCallback() (*LoginCallback, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start login callback listener: %w", err)
+	}
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to generate login state: %w", err)
+	}
+
+	c := &LoginCallback{
+		listener: listener,
+		state:    hex.EncodeToString(state),
+		tokens:   make(chan string, 1),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", c.handle)
+	c.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := c.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("login callback listener failed", "error", err)
+		}
+	}()
+	slog.Debug("login callback listening", "addr", listener.Addr().String())
+	return c, nil
+}
+
+// RedirectURL is where the panel should send the token.
+func (c *LoginCallback) RedirectURL() string {
+	return "http://" + c.listener.Addr().String() + "/callback"
+}
+
+// AuthorizeURL adds the redirect URL and state to the panel's login URL.
+func (c *LoginCallback) AuthorizeURL(loginURL string) string {
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		return loginURL
+	}
+	query := u.Query()
+	query.Set("redirect_uri", c.RedirectURL())
+	query.Set("state", c.state)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// Tokens delivers the token received from the browser.
+func (c *LoginCallback) Tokens() <-chan string {
+	return c.tokens
+}
+
+// Close stops the listener.
+func (c *LoginCallback) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return c.server.Shutdown(ctx)
+}
+
+func (c *LoginCallback) handle(w http.ResponseWriter, r *http.Request) {
+	state := r.FormValue("state")
+	token := strings.TrimSpace(r.FormValue("token"))
+	if subtle.ConstantTimeCompare([]byte(state), []byte(c.state)) != 1 {
+		slog.Warn("login callback with unexpected state", "remote", r.RemoteAddr)
+		http.Error(w, "Invalid login state. Start the login again from the CLI.", http.StatusBadRequest)
+		return
+	}
+	if token == "" {
+		http.Error(w, "The redirect did not include a token.", http.StatusBadRequest)
+		return
+	}
+	select {
+	case c.tokens <- token:
+	default:
+		// A token was already received
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, "<html><body><h3>Virak CLI login complete.</h3><p>You can close this tab and return to the terminal.</p></body></html>")
+}
+
+// BrowserAvailable reports whether a browser can be opened on this machine.
+// SSH sessions and Linux or BSD systems without a display have none.
+func BrowserAvailable() bool {
+	if os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" {
+		return false
+	}
+	switch runtime.GOOS {
+	case "windows", "darwin":
+		return true
+	}
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
+
+// ReadToken reads a token from r, e.g. a file or a pipe. Only the first line is
+// used and surrounding whitespace is ignored.
+func ReadToken(r io.Reader) (string, error) {
+	data, err := io.ReadAll(io.LimitReader(r, 64*1024))
+	if err != nil {
+		return "", err
+	}
+	token, _, _ := strings.Cut(string(data), "\n")
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", errors.New("no token found")
+	}
+	return token, nil
+}
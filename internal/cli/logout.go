@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// RevokeResult describes what happened to a profile's token on the server.
+type RevokeResult int
+
+const (
+	// RevokeNoToken means no token was saved for the profile.
+	RevokeNoToken RevokeResult = iota
+	// Revoked means the server revoked the token.
+	Revoked
+	// RevokeUnsupported means the API cannot revoke tokens; the token stays
+	// valid until it is deleted in the panel.
+	RevokeUnsupported
+	// RevokeInvalid means the server no longer accepted the token.
+	RevokeInvalid
+)
+
+// RevokeToken revokes the token saved for profile on the server, using the
+// profile's API URL. Tokens from --token, VIRAK_TOKEN and credential helpers
+// are not saved and therefore never revoked. With --dry-run the request is
+// printed instead and http.ErrDryRun returned.
+func RevokeToken(ctx context.Context, profile string) (RevokeResult, error) {
+	token, err := profileToken(profile)
+	if err != nil || token == "" {
+		return RevokeNoToken, err
+	}
+	opts := profileClientOptions(profile)
+	if DryRun() {
+		opts = append(opts, http.WithDryRun(os.Stdout))
+	}
+	client := http.NewClient(token, opts...)
+	err = client.RevokeUserToken(ctx)
+	switch {
+	case err == nil:
+		slog.Info("token revoked", "profile", profile)
+		return Revoked, nil
+	case http.IsUnauthorized(err):
+		return RevokeInvalid, nil
+	case http.IsUnsupported(err):
+		slog.Warn("the API does not support revoking tokens", "profile", profile, "error", err)
+		return RevokeUnsupported, nil
+	default:
+		return RevokeNoToken, err
+	}
+}
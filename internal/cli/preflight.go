@@ -28,33 +28,114 @@ func ZoneIDFromContext(ctx context.Context) string {
 	return s
 }
 
-// Preflight returns a PersistentPreRunE-compatible function that ensures login and, if zoneRequired, resolves zoneId
-// via --default-zone or --zoneId flags with consistent error messages.
+// Token returns the API token from --token, VIRAK_TOKEN, the active profile's
+// credential helper or the active profile's saved token, in that order.
+func Token(ctx context.Context) (string, error) {
+	if token := viper.GetString("token"); token != "" {
+		return token, nil
+	}
+	h, err := activeCredentialHelper()
+	if err != nil {
+		return "", err
+	}
+	if h != nil {
+		return h.Token(ctx, false)
+	}
+	return profileToken(ActiveProfile())
+}
+
+// TokenSource describes where Token gets the token from.
+func TokenSource() string {
+	switch {
+	case viper.GetString("token") != "":
+		return "--token or VIRAK_TOKEN"
+	case ProfileString("auth.credentialHelper") != "":
+		return "credential helper"
+	}
+	if viper.GetString(ProfileKey(ActiveProfile(), "auth.token")) != "" {
+		return "config file"
+	}
+	store, err := CredentialStore()
+	if err != nil {
+		return "unknown"
+	}
+	return store.Name()
+}
+
+// APIURL returns the API base URL from --api-url, VIRAK_API_URL or the active
+// profile's apiUrl, in that order. It is empty when the built-in URL applies.
+func APIURL() string {
+	return apiURLOf(ActiveProfile())
+}
+
+// apiURLOf is APIURL for any profile.
+func apiURLOf(profile string) string {
+	if url := viper.GetString("apiUrl"); url != "" {
+		return url
+	}
+	return viper.GetString(ProfileKey(profile, "apiUrl"))
+}
+
+// listingColumns reports whether a list command only prints its columns
+// because of --list-columns, which needs neither the API nor other flags.
+func listingColumns(cmd *cobra.Command) bool {
+	listColumns, _ := cmd.Flags().GetBool("list-columns")
+	return listColumns
+}
+
+// Preflight returns a PersistentPreRunE-compatible function that ensures login, checks the token abilities declared
+// with RequireAbilities and, if zoneRequired, resolves zoneId
+// from the --zoneId flag, VIRAK_ZONE_ID or the active profile's default zone, in that order, with consistent error messages.
+// Names given to the zone and to ID flags such as --instanceId are then resolved to IDs, see Resolve.
 func Preflight(zoneRequired bool) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
-		token := viper.GetString("auth.token")
+		if listingColumns(cmd) {
+			return nil
+		}
+		profile := ActiveProfile()
+		token, err := Token(cmd.Context())
+		if err != nil {
+			slog.Error("failed to read token", "profile", profile, "error", err)
+			return err
+		}
 		if token == "" {
-			slog.Error("not logged in")
-			return fmt.Errorf("you must be logged in to use this command. Please run 'virak-cli login' first")
+			if profile != DefaultProfile && !ProfileExists(profile) {
+				slog.Error("profile not found", "profile", profile)
+				return AuthError(fmt.Errorf("profile %q does not exist. Create it with 'virak-cli profile create %s' or 'virak-cli login --profile %s'", profile, profile, profile))
+			}
+			slog.Error("not logged in", "profile", profile)
+			if profile != DefaultProfile {
+				return AuthError(fmt.Errorf("you must be logged in to use this command. Please run 'virak-cli login --profile %s' first", profile))
+			}
+			return AuthError(fmt.Errorf("you must be logged in to use this command. Please run 'virak-cli login' first"))
+		}
+
+		if err := checkAbilities(cmd, token); err != nil {
+			return err
 		}
 
 		zoneId, _ := cmd.Flags().GetString("zoneId")
+		if zoneId == "" {
+			zoneId = viper.GetString("zoneId")
+		}
 
-		if zoneRequired {
-			// Always try to use the default zoneId from config if set
-			if defaultZoneId := viper.GetString("default.zoneId"); defaultZoneId != "" {
-				zoneId = defaultZoneId
-			} else if zoneId == "" {
-				slog.Error("--zoneId flag required when --default-zone not set")
-				return fmt.Errorf("--zoneId flag required when --default-zone not set")
+		if zoneRequired && zoneId == "" {
+			// Fall back to the default zone saved with 'virak-cli zone list'
+			zoneId = ProfileString("default.zoneId")
+			if zoneId == "" {
+				slog.Error("--zoneId flag required when no default zone is set")
+				return UsageError(fmt.Errorf("--zoneId flag required when no default zone is set (set VIRAK_ZONE_ID or choose one with 'virak-cli zone list')"))
 			}
 		}
 
-		ctx := context.WithValue(cmd.Context(), ctxTokenKey, token)
+		cmd.SetContext(context.WithValue(cmd.Context(), ctxTokenKey, token))
+		zoneId, err = resolveFlags(cmd, token, zoneId)
+		if err != nil {
+			return err
+		}
 		if zoneId != "" {
-			ctx = context.WithValue(ctx, ctxZoneIDKey, zoneId)
+			cmd.SetContext(context.WithValue(cmd.Context(), ctxZoneIDKey, zoneId))
 		}
-		cmd.SetContext(ctx)
 		return nil
 	}
 }
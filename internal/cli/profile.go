@@ -0,0 +1,247 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultProfile is used when no profile has been selected.
+const DefaultProfile = "default"
+
+// Profiles are stored as sections of the profiles key, e.g.
+//
+//	activeProfile: staging
+//	profiles:
+//	  staging:
+//	    auth:
+//	      token: ...
+//	    default:
+//	      zoneId: ...
+//
+// Viper lower-cases keys, so profile names are lower case too.
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateProfileName reports whether name can be used as a profile name.
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use lower-case letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
+
+// ActiveProfile returns the profile selected by --profile, VIRAK_PROFILE or the
+// activeProfile config key, in that order, falling back to DefaultProfile.
+func ActiveProfile() string {
+	if name := viper.GetString("profile"); name != "" {
+		return strings.ToLower(name)
+	}
+	if name := viper.GetString("activeProfile"); name != "" {
+		return strings.ToLower(name)
+	}
+	return DefaultProfile
+}
+
+// Profiles returns the names of the profiles in the config, sorted.
+func Profiles() []string {
+	var names []string
+	for name := range viper.GetStringMap("profiles") {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ProfileExists reports whether the config has a profile called name.
+func ProfileExists(name string) bool {
+	return slices.Contains(Profiles(), name)
+}
+
+// ProfileKey returns the config key of key within profile, e.g.
+// profiles.staging.auth.token.
+func ProfileKey(profile, key string) string {
+	return "profiles." + profile + "." + key
+}
+
+// ProfileString returns key from the active profile.
+func ProfileString(key string) string {
+	return viper.GetString(ProfileKey(ActiveProfile(), key))
+}
+
+// profileSetting returns the config key to read a setting from: the active
+// profile's own value when present, otherwise the top-level value shared by all
+// profiles.
+func profileSetting(key string) string {
+	return profileSettingOf(ActiveProfile(), key)
+}
+
+// profileSettingOf is profileSetting for any profile.
+func profileSettingOf(profile, key string) string {
+	if k := ProfileKey(profile, key); viper.IsSet(k) {
+		return k
+	}
+	return key
+}
+
+// SetProfileValues stores values in the active profile, creating it if needed,
+// and writes the config to disk.
+func SetProfileValues(values map[string]any) error {
+	profile := ActiveProfile()
+	return EditConfig(func(settings map[string]any) error {
+		for key, value := range values {
+			setPath(settings, ProfileKey(profile, key), value)
+		}
+		return nil
+	})
+}
+
+// CreateProfile adds an empty profile to the config.
+func CreateProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if ProfileExists(name) {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	return EditConfig(func(settings map[string]any) error {
+		setPath(settings, ProfileKey(name, "auth.token"), "")
+		return nil
+	})
+}
+
+// UseProfile makes name the profile used when neither --profile nor
+// VIRAK_PROFILE is set.
+func UseProfile(name string) error {
+	if !ProfileExists(name) {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	return EditConfig(func(settings map[string]any) error {
+		settings["activeprofile"] = name
+		return nil
+	})
+}
+
+// DeleteProfile removes a profile and its credentials. The profile saved as
+// active cannot be deleted.
+func DeleteProfile(name string) error {
+	if !ProfileExists(name) {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	if name == savedProfile() {
+		return fmt.Errorf("profile %q is the active profile; switch to another profile with 'virak-cli profile use' first", name)
+	}
+	if err := DeleteToken(name); err != nil {
+		return err
+	}
+	if err := PurgeProfileData(name); err != nil {
+		return err
+	}
+	return EditConfig(func(settings map[string]any) error {
+		deletePath(settings, "profiles."+name)
+		return nil
+	})
+}
+
+// RenameProfile renames a profile, keeping it active if it was.
+func RenameProfile(oldName, newName string) error {
+	if !ProfileExists(oldName) {
+		return fmt.Errorf("profile %q does not exist", oldName)
+	}
+	if err := ValidateProfileName(newName); err != nil {
+		return err
+	}
+	if ProfileExists(newName) {
+		return fmt.Errorf("profile %q already exists", newName)
+	}
+	wasActive := oldName == savedProfile()
+	if err := moveToken(oldName, newName); err != nil {
+		return err
+	}
+	if err := moveProfileData(oldName, newName); err != nil {
+		return err
+	}
+	return EditConfig(func(settings map[string]any) error {
+		setPath(settings, "profiles."+newName, getPath(settings, "profiles."+oldName))
+		deletePath(settings, "profiles."+oldName)
+		if wasActive {
+			settings["activeprofile"] = newName
+		}
+		return nil
+	})
+}
+
+// moveToken moves a token kept in the credential store to another profile.
+func moveToken(from, to string) error {
+	store, err := CredentialStore()
+	if err != nil {
+		return err
+	}
+	// Tokens in the config move with the profile section
+	if _, ok := store.(configStore); ok {
+		return nil
+	}
+	if ok, err := store.Has(from); err != nil || !ok {
+		return err
+	}
+	token, err := store.Get(from)
+	if err != nil {
+		return err
+	}
+	if err := store.Set(to, token); err != nil {
+		return err
+	}
+	return store.Delete(from)
+}
+
+// savedProfile returns the active profile stored in the config, ignoring
+// --profile and VIRAK_PROFILE.
+func savedProfile() string {
+	if name := viper.GetString("activeProfile"); name != "" {
+		return strings.ToLower(name)
+	}
+	return DefaultProfile
+}
+
+// MigrateLegacyConfig moves the top-level auth and default sections written by
+// earlier versions into the default profile.
+func MigrateLegacyConfig() error {
+	if !viper.InConfig("auth") && !viper.InConfig("default") {
+		return nil
+	}
+	return EditConfig(func(settings map[string]any) error {
+		for _, section := range []string{"auth", "default"} {
+			value, ok := settings[section]
+			if !ok {
+				continue
+			}
+			delete(settings, section)
+			if getPath(settings, ProfileKey(DefaultProfile, section)) == nil {
+				setPath(settings, ProfileKey(DefaultProfile, section), value)
+			}
+		}
+		return nil
+	})
+}
+
+// Profile summarizes a profile for display.
+type Profile struct {
+	Name     string `json:"name"`
+	Active   bool   `json:"active"`
+	LoggedIn bool   `json:"logged_in"`
+	ZoneID   string `json:"zone_id"`
+	ZoneName string `json:"zone_name"`
+}
+
+// LoadProfile returns the settings of the profile called name.
+func LoadProfile(name string) Profile {
+	return Profile{
+		Name:     name,
+		Active:   name == ActiveProfile(),
+		LoggedIn: HasToken(name),
+		ZoneID:   viper.GetString(ProfileKey(name, "default.zoneId")),
+		ZoneName: viper.GetString(ProfileKey(name, "default.zoneName")),
+	}
+}
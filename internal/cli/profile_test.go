@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// writeConfig writes config to the config file in home and loads it.
+func writeConfig(t *testing.T, home, config string) string {
+	t.Helper()
+	path := filepath.Join(home, ".virak-cli.yaml")
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestActiveProfile(t *testing.T) {
+	tempHome(t)
+	t.Setenv("VIRAK_PROFILE", "")
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("profile", "", "")
+	_ = viper.BindPFlag("profile", flags.Lookup("profile"))
+	_ = viper.BindEnv("profile", "VIRAK_PROFILE")
+
+	if got := ActiveProfile(); got != DefaultProfile {
+		t.Errorf("got %s without a selection, want %s", got, DefaultProfile)
+	}
+	viper.Set("activeProfile", "Staging")
+	if got := ActiveProfile(); got != "staging" {
+		t.Errorf("got %s, want staging from the config", got)
+	}
+	t.Setenv("VIRAK_PROFILE", "ci")
+	if got := ActiveProfile(); got != "ci" {
+		t.Errorf("got %s, want ci from VIRAK_PROFILE over the config", got)
+	}
+	if err := flags.Parse([]string{"--profile", "prod"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := ActiveProfile(); got != "prod" {
+		t.Errorf("got %s, want prod from --profile over VIRAK_PROFILE", got)
+	}
+	if got := savedProfile(); got != "staging" {
+		t.Errorf("got saved profile %s, want staging from the config", got)
+	}
+}
+
+func TestMigrateLegacyConfig(t *testing.T) {
+	home := tempHome(t)
+	path := writeConfig(t, home, `output: json
+auth:
+  token: legacy-token
+default:
+  zoneId: 01J9Y6ZQ5HZ0NE0000000000T1
+  zoneName: Tehran-1
+profiles:
+  staging:
+    auth:
+      token: staging-token
+`)
+
+	if err := MigrateLegacyConfig(); err != nil {
+		t.Fatal(err)
+	}
+	settings, err := ReadConfigFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := settings["auth"]; ok {
+		t.Error("the top-level auth section is still there")
+	}
+	if _, ok := settings["default"]; ok {
+		t.Error("the top-level default section is still there")
+	}
+	for key, want := range map[string]string{
+		ProfileKey(DefaultProfile, "auth.token"):       "legacy-token",
+		ProfileKey(DefaultProfile, "default.zoneId"):   "01J9Y6ZQ5HZ0NE0000000000T1",
+		ProfileKey(DefaultProfile, "default.zoneName"): "Tehran-1",
+		ProfileKey("staging", "auth.token"):            "staging-token",
+		"output":                                       "json",
+	} {
+		if got := viper.GetString(key); got != want {
+			t.Errorf("got %s = %q, want %q", key, got, want)
+		}
+	}
+	if errs := ValidateConfig(settings); len(errs) != 0 {
+		t.Errorf("got problems %v in the migrated config", errs)
+	}
+
+	// Migrating again changes nothing
+	before, _ := os.ReadFile(path)
+	if err := MigrateLegacyConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if after, _ := os.ReadFile(path); string(after) != string(before) {
+		t.Errorf("the config changed from:\n%s\nto:\n%s", before, after)
+	}
+}
+
+func TestMigrateLegacyConfigKeepsTheDefaultProfile(t *testing.T) {
+	home := tempHome(t)
+	writeConfig(t, home, `auth:
+  token: legacy-token
+profiles:
+  default:
+    auth:
+      token: new-token
+`)
+
+	if err := MigrateLegacyConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if got := viper.GetString(ProfileKey(DefaultProfile, "auth.token")); got != "new-token" {
+		t.Errorf("got token %q, want the default profile's new-token", got)
+	}
+	if viper.InConfig("auth") {
+		t.Error("the top-level auth section is still there")
+	}
+}
+
+func TestProfileErrors(t *testing.T) {
+	home := tempHome(t)
+	writeConfig(t, home, `activeprofile: default
+profiles:
+  default:
+    default:
+      zoneId: 01J9Y6ZQ5HZ0NE0000000000T1
+  staging:
+    apiUrl: https://staging.example.com
+`)
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"use missing", UseProfile("prod"), `profile "prod" does not exist`},
+		{"delete missing", DeleteProfile("prod"), `profile "prod" does not exist`},
+		{"rename missing", RenameProfile("prod", "live"), `profile "prod" does not exist`},
+		{"delete active", DeleteProfile("default"), `profile "default" is the active profile`},
+		{"create existing", CreateProfile("staging"), `profile "staging" already exists`},
+		{"create invalid", CreateProfile("Prod"), `invalid profile name "Prod"`},
+		{"rename to existing", RenameProfile("staging", "default"), `profile "default" already exists`},
+		{"rename to invalid", RenameProfile("staging", "my prod"), `invalid profile name "my prod"`},
+	}
+	for _, tt := range tests {
+		if tt.err == nil || !strings.HasPrefix(tt.err.Error(), tt.want) {
+			t.Errorf("%s: got error %v, want %s", tt.name, tt.err, tt.want)
+		}
+	}
+	if got := Profiles(); strings.Join(got, ",") != "default,staging" {
+		t.Errorf("got profiles %v after the failures, want default and staging", got)
+	}
+}
+
+func TestPreflightMissingProfile(t *testing.T) {
+	tempHome(t)
+	cmd := &cobra.Command{Use: "list"}
+
+	viper.Set("profile", "prod")
+	err := Preflight(false)(cmd, nil)
+	if ExitCode(err) != ExitAuth || !strings.Contains(err.Error(), `profile "prod" does not exist. Create it with 'virak-cli profile create prod'`) {
+		t.Errorf("got error %v, want the missing profile", err)
+	}
+
+	// A profile without a token asks for a login instead
+	if err := CreateProfile("prod"); err != nil {
+		t.Fatal(err)
+	}
+	err = Preflight(false)(cmd, nil)
+	if ExitCode(err) != ExitAuth || !strings.Contains(err.Error(), "virak-cli login --profile prod") {
+		t.Errorf("got error %v, want a login for the profile", err)
+	}
+}
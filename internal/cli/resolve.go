@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// resolvedFlags are the ID flags Preflight resolves, so every command taking
+// them accepts names too. Snapshot IDs are not resolved, as snapshots are
+// listed per instance, and neither are instance network IDs, which have no
+// names.
+var resolvedFlags = map[string]Resource{
+	"zoneId":      Zone,
+	"instanceId":  Instance,
+	"instance-id": Instance,
+	"networkId":   Network,
+	"bucketId":    Bucket,
+	"clusterId":   Cluster,
+	"volumeId":    Volume,
+	"sshKeyId":    SSHKey,
+}
+
+// resolvedListFlags are the flags taking a JSON array of IDs that Preflight
+// resolves like resolvedFlags.
+var resolvedListFlags = map[string]Resource{
+	"network-ids": Network,
+}
+
+// Resolve returns the ID of the resource ref refers to. ref is an ID, a name,
+// or either one prefixed with "id:" or "name:" to say which it is. Names are
+// looked up with the resource's list call and must match a single resource,
+// exactly or else ignoring case. IDs are returned as given.
+func Resolve(ctx context.Context, client *http.Client, zoneID string, resource Resource, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, ok := strings.CutPrefix(ref, "id:"); ok {
+		return strings.TrimSpace(id), nil
+	}
+	name, byName := strings.CutPrefix(ref, "name:")
+	name = strings.TrimSpace(name)
+	if !byName && isValidUlid(ref) {
+		return ref, nil
+	}
+	if name == "" {
+		return "", UsageError(fmt.Errorf("no %s name given", resource.Kind))
+	}
+	if resource.Zonal && zoneID == "" {
+		return "", UsageError(fmt.Errorf("--zoneId is required to look up %s %q by name", resource.Kind, name))
+	}
+
+	all, err := resource.list(ctx, client, zoneID)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s %q: %w", resource.Kind, name, err)
+	}
+	matches := matchName(all, name, func(a, b string) bool { return a == b })
+	if len(matches) == 0 {
+		matches = matchName(all, name, strings.EqualFold)
+	}
+	switch len(matches) {
+	case 0:
+		if resource.Zonal {
+			return "", NotFoundError(fmt.Errorf("no %s named %q in zone %s", resource.Kind, name, zoneID))
+		}
+		return "", NotFoundError(fmt.Errorf("no %s named %q", resource.Kind, name))
+	case 1:
+		slog.Debug("resolved name", "kind", resource.Kind, "name", name, "id", matches[0].ID)
+		return matches[0].ID, nil
+	}
+	var candidates []string
+	for _, m := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", m.ID, m.Name))
+	}
+	return "", UsageError(fmt.Errorf("%d %ss are named %q: %s; pass id:<ID> to pick one", len(matches), resource.Kind, name, strings.Join(candidates, ", ")))
+}
+
+func matchName(all []namedResource, name string, equal func(a, b string) bool) []namedResource {
+	var matches []namedResource
+	for _, r := range all {
+		if equal(r.Name, name) {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+// resolveFlags replaces the names given to the flags of resolvedFlags and
+// resolvedListFlags with the IDs they refer to, before the command reads them.
+// The zone, which may also come from VIRAK_ZONE_ID, is resolved first, as the
+// other names are looked up in it, and its ID is returned.
+func resolveFlags(cmd *cobra.Command, token, zoneID string) (string, error) {
+	var client *http.Client
+	resolve := func(resource Resource, scope, ref string) (string, error) {
+		if client == nil {
+			client = http.NewClient(token, ClientOptions()...)
+		}
+		return Resolve(cmd.Context(), client, scope, resource, ref)
+	}
+
+	if zoneID != "" {
+		id, err := resolve(Zone, "", zoneID)
+		if err != nil {
+			return "", fmt.Errorf("--zoneId: %w", err)
+		}
+		if flag := cmd.Flags().Lookup("zoneId"); flag != nil && flag.Value.String() == zoneID && id != zoneID {
+			if err := cmd.Flags().Set("zoneId", id); err != nil {
+				return "", err
+			}
+		}
+		zoneID = id
+	}
+
+	for name, resource := range resolvedFlags {
+		flag := cmd.Flags().Lookup(name)
+		if name == "zoneId" || flag == nil || flag.Value.Type() != "string" || flag.Value.String() == "" {
+			continue
+		}
+		ref := flag.Value.String()
+		id, err := resolve(resource, zoneID, ref)
+		if err != nil {
+			return "", fmt.Errorf("--%s: %w", name, err)
+		}
+		if id != ref {
+			if err := cmd.Flags().Set(name, id); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	for name, resource := range resolvedListFlags {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Value.Type() != "string" || flag.Value.String() == "" {
+			continue
+		}
+		// Values that are not a JSON array are left to the command to reject
+		var refs []string
+		if err := json.Unmarshal([]byte(flag.Value.String()), &refs); err != nil {
+			continue
+		}
+		ids := make([]string, len(refs))
+		for i, ref := range refs {
+			id, err := resolve(resource, zoneID, ref)
+			if err != nil {
+				return "", fmt.Errorf("--%s: %w", name, err)
+			}
+			ids[i] = id
+		}
+		if !slices.Equal(ids, refs) {
+			data, err := json.Marshal(ids)
+			if err != nil {
+				return "", err
+			}
+			if err := cmd.Flags().Set(name, string(data)); err != nil {
+				return "", err
+			}
+		}
+	}
+	return zoneID, nil
+}
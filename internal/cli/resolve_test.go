@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+const fakeL2Offering = "01J9Y6ZQ5HNETW0RK000000002"
+
+// fakeNetworks starts a fake API with networks of the given names and returns
+// a client for it and the networks' IDs by name.
+func fakeNetworks(t *testing.T, names ...string) (*http.Client, string, map[string]string) {
+	t.Helper()
+	srv := httptest.NewServer(fake.NewServer())
+	t.Cleanup(srv.Close)
+	client := http.NewClient("token", http.WithBaseURL(srv.URL))
+	ctx := context.Background()
+	for _, name := range names {
+		if _, err := client.CreateL2Network(ctx, fake.DefaultZoneID, fakeL2Offering, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	networks, err := client.ListNetworks(ctx, fake.DefaultZoneID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[string]string{}
+	for _, n := range networks.Data {
+		ids[n.Name] = n.ID
+	}
+	return client, srv.URL, ids
+}
+
+func TestResolve(t *testing.T) {
+	client, _, ids := fakeNetworks(t, "web", "WEB", "db", "01J9Y6ZQ5HNAMED0000000000D")
+	const unknownID = "01J9Y6ZQ5H00000000000000ZZ"
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"web", ids["web"]},
+		{"WEB", ids["WEB"]},
+		{" db ", ids["db"]},
+		{"DB", ids["db"]},
+		{"name:db", ids["db"]},
+		{"name: db", ids["db"]},
+		// IDs are not looked up, so unknown ones are left to the API
+		{unknownID, unknownID},
+		{"id:" + unknownID, unknownID},
+		{"id:not-a-ulid", "not-a-ulid"},
+		// A name that looks like an ID needs name:
+		{"01J9Y6ZQ5HNAMED0000000000D", "01J9Y6ZQ5HNAMED0000000000D"},
+		{"name:01J9Y6ZQ5HNAMED0000000000D", ids["01J9Y6ZQ5HNAMED0000000000D"]},
+	}
+	for _, tt := range tests {
+		got, err := Resolve(context.Background(), client, fake.DefaultZoneID, Network, tt.ref)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tt.ref, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Resolve(%q) = %s, want %s", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	client, _, ids := fakeNetworks(t, "web", "WEB")
+	tests := []struct {
+		name   string
+		zoneID string
+		ref    string
+		code   int
+		want   []string
+	}{
+		{"not found", fake.DefaultZoneID, "api", ExitNotFound, []string{`no network named "api" in zone ` + fake.DefaultZoneID}},
+		{"ambiguous", fake.DefaultZoneID, "Web", ExitUsage, []string{`2 networks are named "Web"`, ids["web"], ids["WEB"], "id:<ID>"}},
+		{"empty name", fake.DefaultZoneID, "name:", ExitUsage, []string{"no network name given"}},
+		{"no zone", "", "web", ExitUsage, []string{"--zoneId is required"}},
+		{"unknown zone", "01J9Y6ZQ5H00000000000000ZZ", "web", ExitNotFound, []string{`failed to look up network "web"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Resolve(context.Background(), client, tt.zoneID, Network, tt.ref)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			if code := ExitCode(err); code != tt.code {
+				t.Errorf("got exit code %d for %v, want %d", code, err, tt.code)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("got error %v, want it to contain %s", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveFlags(t *testing.T) {
+	_, url, ids := fakeNetworks(t, "web")
+	viper.Set("apiUrl", url)
+	t.Cleanup(viper.Reset)
+
+	var networkID, name string
+	cmd := &cobra.Command{}
+	cmd.Flags().StringVar(&networkID, "networkId", "", "")
+	cmd.Flags().StringVar(&name, "name", "", "")
+	cmd.SetContext(context.Background())
+	if err := cmd.Flags().Parse([]string{"--networkId", "web", "--name", "web"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolveFlags(cmd, "token", fake.DefaultZoneID); err != nil {
+		t.Fatal(err)
+	}
+	if networkID != ids["web"] || name != "web" {
+		t.Errorf("got --networkId %s and --name %s, want the network's ID and the name unchanged", networkID, name)
+	}
+
+	if err := cmd.Flags().Set("networkId", "api"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := resolveFlags(cmd, "token", fake.DefaultZoneID)
+	if err == nil || !strings.HasPrefix(err.Error(), "--networkId: ") || ExitCode(err) != ExitNotFound {
+		t.Errorf("got error %v, want a not found error naming the flag", err)
+	}
+}
+
+func TestResolveFlagsZoneAndLists(t *testing.T) {
+	_, url, ids := fakeNetworks(t, "web", "db")
+	viper.Set("apiUrl", url)
+	t.Cleanup(viper.Reset)
+
+	var zoneID, networkIDs string
+	cmd := &cobra.Command{}
+	cmd.Flags().StringVar(&zoneID, "zoneId", "", "")
+	cmd.Flags().StringVar(&networkIDs, "network-ids", "", "")
+	cmd.SetContext(context.Background())
+	if err := cmd.Flags().Parse([]string{"--zoneId", "tehran-1", "--network-ids", `["web", "id:` + ids["db"] + `"]`}); err != nil {
+		t.Fatal(err)
+	}
+	zone, err := resolveFlags(cmd, "token", zoneID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zone != fake.DefaultZoneID || zoneID != fake.DefaultZoneID {
+		t.Errorf("got zone %s and --zoneId %s, want %s", zone, zoneID, fake.DefaultZoneID)
+	}
+	if want := `["` + ids["web"] + `","` + ids["db"] + `"]`; networkIDs != want {
+		t.Errorf("got --network-ids %s, want %s", networkIDs, want)
+	}
+
+	// A zone from VIRAK_ZONE_ID is resolved without a flag to set
+	noFlags := &cobra.Command{}
+	noFlags.SetContext(context.Background())
+	zone, err = resolveFlags(noFlags, "token", "Tehran-1")
+	if err != nil || zone != fake.DefaultZoneID {
+		t.Errorf("got zone %s, %v, want %s", zone, err, fake.DefaultZoneID)
+	}
+	if _, err := resolveFlags(noFlags, "token", "Mashhad-1"); err == nil || !strings.HasPrefix(err.Error(), "--zoneId: ") || ExitCode(err) != ExitNotFound {
+		t.Errorf("got error %v, want a not found zone", err)
+	}
+
+	if err := cmd.Flags().Set("network-ids", `["web", "cache"]`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolveFlags(cmd, "token", fake.DefaultZoneID); err == nil || !strings.Contains(err.Error(), `--network-ids: no network named "cache"`) {
+		t.Errorf("got error %v, want the unknown network", err)
+	}
+	// The command reports values that are no JSON array
+	if err := cmd.Flags().Set("network-ids", "web,db"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolveFlags(cmd, "token", fake.DefaultZoneID); err != nil || networkIDs != "web,db" {
+		t.Errorf("got --network-ids %s, %v, want it unchanged", networkIDs, err)
+	}
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/virak-cloud/cli/pkg/http"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Resource is a kind of resource the CLI can list to resolve names given to
+// ID flags and to complete flag values.
+type Resource struct {
+	// Kind names the resource in messages, e.g. "instance".
+	Kind string
+	// Zonal resources are listed per zone.
+	Zonal bool
+	// inDomain resources are listed per DNS domain, given with --domain.
+	inDomain bool
+	// list returns the resources of scope, the zone or domain they are in.
+	list func(ctx context.Context, client *http.Client, scope string) ([]namedResource, error)
+}
+
+type namedResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func named[T any](items []T, fields func(T) (id, name string)) []namedResource {
+	named := make([]namedResource, 0, len(items))
+	for _, item := range items {
+		id, name := fields(item)
+		named = append(named, namedResource{ID: id, Name: name})
+	}
+	return named
+}
+
+var (
+	Zone = Resource{Kind: "zone", list: func(ctx context.Context, client *http.Client, _ string) ([]namedResource, error) {
+		resp, err := client.GetZoneList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.Zone) (string, string) { return r.ID, r.Name }), nil
+	}}
+	Instance = Resource{Kind: "instance", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListInstances(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.Instance) (string, string) { return r.ID, r.Name }), nil
+	}}
+	Network = Resource{Kind: "network", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListNetworks(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.Network) (string, string) { return r.ID, r.Name }), nil
+	}}
+	Bucket = Resource{Kind: "bucket", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.GetObjectStorageBuckets(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.ObjectStorageBucket) (string, string) { return r.ID, r.Name }), nil
+	}}
+	Cluster = Resource{Kind: "cluster", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.GetKubernetesClusters(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.KubernetesCluster) (string, string) { return r.ID, r.Name }), nil
+	}}
+	Volume = Resource{Kind: "volume", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListInstanceVolumes(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.InstanceVolume) (string, string) { return r.ID, r.Name }), nil
+	}}
+	SSHKey = Resource{Kind: "SSH key", list: func(ctx context.Context, client *http.Client, _ string) ([]namedResource, error) {
+		resp, err := client.ListUserSSHKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.UserData, func(r responses.UserSSHKey) (string, string) { return r.ID, r.DisplayName }), nil
+	}}
+	Domain = Resource{Kind: "domain", list: func(ctx context.Context, client *http.Client, _ string) ([]namedResource, error) {
+		resp, err := client.GetDomains(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.Domain) (string, string) { return r.Domain, r.Status }), nil
+	}}
+	Record = Resource{Kind: "record", inDomain: true, list: func(ctx context.Context, client *http.Client, domain string) ([]namedResource, error) {
+		resp, err := client.GetRecords(ctx, domain)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.Record) (string, string) { return r.Name, r.Type }), nil
+	}}
+	InstanceServiceOffering = Resource{Kind: "instance service offering", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListInstanceServiceOfferings(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.InstanceServiceOffering) (string, string) { return r.ID, r.Name }), nil
+	}}
+	VolumeServiceOffering = Resource{Kind: "volume service offering", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListInstanceVolumeServiceOfferings(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.InstanceVolumeServiceOffering) (string, string) { return r.ID, r.Name }), nil
+	}}
+	NetworkServiceOffering = Resource{Kind: "network service offering", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListNetworkServiceOfferings(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.NetworkOffering) (string, string) { return r.ID, r.Name }), nil
+	}}
+	KubernetesServiceOffering = Resource{Kind: "Kubernetes service offering", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.GetKubernetesServiceOfferings(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.KubernetesServiceOffering) (string, string) { return r.ID, r.Name }), nil
+	}}
+	VMImage = Resource{Kind: "VM image", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.ListInstanceVMImages(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.InstanceVMImage) (string, string) { return r.ID, r.DisplayText }), nil
+	}}
+	KubernetesVersion = Resource{Kind: "Kubernetes version", Zonal: true, list: func(ctx context.Context, client *http.Client, zoneID string) ([]namedResource, error) {
+		resp, err := client.GetKubernetesVersions(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		return named(resp.Data, func(r responses.KubernetesVersion) (string, string) { return r.ID, r.Version }), nil
+	}}
+)
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/oklog/ulid/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type Values interface {
@@ -48,6 +50,26 @@ func OneOf(name string, allowed ...string) Rule {
 	})
 }
 
+// enumAnnotation is the flag annotation holding the values declared with Enum.
+const enumAnnotation = "virak-cli/enum"
+
+// Enum declares the values flag name of cmd accepts. Validate checks them like
+// OneOf, and shell completion offers them.
+func Enum(cmd *cobra.Command, name string, allowed ...string) {
+	_ = cmd.Flags().SetAnnotation(name, enumAnnotation, allowed)
+}
+
+// enumRules returns the OneOf rules of the flags of cmd declared with Enum.
+func enumRules(cmd *cobra.Command) []Rule {
+	var rules []Rule
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if allowed := flag.Annotations[enumAnnotation]; allowed != nil {
+			rules = append(rules, OneOf(flag.Name, allowed...))
+		}
+	})
+	return rules
+}
+
 func RequiredIf(name string, predicate func(v Values) bool) Rule {
 	return RuleFunc(func(v Values) error {
 		if predicate(v) && !v.Changed(name) {
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stateDir returns ~/.virak-cli, which holds credentials, logs and cached API
+// data.
+func stateDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".virak-cli"), nil
+}
+
+// profileDir returns the directory of profile under kind, e.g. logs.
+func profileDir(kind, profile string) (string, error) {
+	if err := ValidateProfileName(profile); err != nil {
+		return "", err
+	}
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, kind, profile), nil
+}
+
+// LogDir returns the directory of the log files of profile.
+func LogDir(profile string) (string, error) {
+	return profileDir("logs", profile)
+}
+
+// CacheDir returns the directory of the API data cached for profile.
+func CacheDir(profile string) (string, error) {
+	return profileDir("cache", profile)
+}
+
+// hashKey returns a hex digest of parts, to name cached data after values
+// such as tokens that must not be written to disk themselves.
+func hashKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// PurgeProfileData removes the logs and cached data of profile.
+func PurgeProfileData(profile string) error {
+	var errs []error
+	for _, dir := range []func(string) (string, error){LogDir, CacheDir} {
+		path, err := dir(profile)
+		if err == nil {
+			err = os.RemoveAll(path)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove local data of profile %q: %w", profile, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// moveProfileData moves the logs and cached data of a renamed profile.
+func moveProfileData(from, to string) error {
+	for _, dir := range []func(string) (string, error){LogDir, CacheDir} {
+		oldPath, err := dir(from)
+		if err != nil {
+			return err
+		}
+		newPath, err := dir(to)
+		if err != nil {
+			return err
+		}
+		if err := os.Rename(oldPath, newPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to move local data of profile %q: %w", from, err)
+		}
+	}
+	return nil
+}
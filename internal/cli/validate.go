@@ -3,10 +3,13 @@ package cli
 import "github.com/spf13/cobra"
 
 func Validate(cmd *cobra.Command, rules ...Rule) error {
+	if listingColumns(cmd) {
+		return nil
+	}
 	v := NewCobraValues(cmd)
-	for _, r := range rules {
+	for _, r := range append(enumRules(cmd), rules...) {
 		if err := r.Validate(v); err != nil {
-			return err
+			return UsageError(err)
 		}
 	}
 	return nil
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// Wait blocks until poll reports the state described by opts and prints
+// progress to stderr. what names the resource in messages, e.g. `instance "web"`.
+func Wait(ctx context.Context, what string, poll http.PollFunc, opts http.WaitOptions) error {
+	opts.Progress = func(state http.ResourceState, elapsed time.Duration) {
+		elapsed = elapsed.Round(time.Second)
+		switch {
+		case state.Gone && opts.UntilGone:
+			fmt.Fprintf(os.Stderr, "%s is gone (%s)\n", what, elapsed)
+		case state.Gone:
+			fmt.Fprintf(os.Stderr, "Waiting for %s to appear (%s)\n", what, elapsed)
+		default:
+			fmt.Fprintf(os.Stderr, "Waiting for %s: status %s (%s)\n", what, state.Status, elapsed)
+		}
+	}
+	state, err := http.Wait(ctx, poll, opts)
+	if err != nil {
+		return fmt.Errorf("waiting for %s: %w", what, err)
+	}
+	if !opts.UntilGone {
+		fmt.Fprintf(os.Stderr, "%s is %s\n", what, state.Status)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt parameters recommended for interactive logins.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	keyLength    = 32
+	saltLength   = 16
+	sealedFormat = "virak-cli credentials v1"
+	// maxScryptCost caps N*r*p of files being opened, which come from disk and
+	// could otherwise demand any amount of memory and CPU. It allows eight times
+	// the parameters above.
+	maxScryptCost = 8 * scryptN * scryptR * scryptP
+)
+
+// encryption records how the ciphertext of a FileStore was produced.
+type encryption struct {
+	Cipher string `json:"cipher"`
+	KDF    string `json:"kdf"`
+	N      int    `json:"n"`
+	R      int    `json:"r"`
+	P      int    `json:"p"`
+	Salt   []byte `json:"salt"`
+	Nonce  []byte `json:"nonce"`
+}
+
+// ErrWrongPassphrase is returned when encrypted credentials cannot be opened.
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted credentials file")
+
+// seal encrypts plaintext with AES-256-GCM under a key derived from passphrase
+// with scrypt and a fresh salt.
+func seal(plaintext, passphrase []byte) (*encryption, []byte, error) {
+	enc := &encryption{Cipher: "aes-256-gcm", KDF: "scrypt", N: scryptN, R: scryptR, P: scryptP, Salt: make([]byte, saltLength)}
+	if _, err := rand.Read(enc.Salt); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	aead, err := enc.aead(passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	enc.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(enc.Nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return enc, aead.Seal(nil, enc.Nonce, plaintext, []byte(sealedFormat)), nil
+}
+
+// open decrypts a ciphertext produced by seal.
+func open(enc *encryption, ciphertext, passphrase []byte) ([]byte, error) {
+	if enc.Cipher != "aes-256-gcm" || enc.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported credentials encryption %s/%s", enc.Cipher, enc.KDF)
+	}
+	if err := enc.checkCost(); err != nil {
+		return nil, err
+	}
+	aead, err := enc.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, enc.Nonce, ciphertext, []byte(sealedFormat))
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plaintext, nil
+}
+
+// checkCost rejects scrypt parameters that are invalid or too expensive.
+func (enc *encryption) checkCost() error {
+	n, r, p := enc.N, enc.R, enc.P
+	if n < 2 || n&(n-1) != 0 || r < 1 || p < 1 || n > maxScryptCost || r > maxScryptCost/n || p > maxScryptCost/(n*r) {
+		return fmt.Errorf("unsupported scrypt parameters N=%d r=%d p=%d in credentials file", n, r, p)
+	}
+	return nil
+}
+
+func (enc *encryption) aead(passphrase []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, enc.Salt, enc.N, enc.R, enc.P, keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,107 @@
+package credentials
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	plaintext := []byte(`{"default":"secret-token"}`)
+	enc, ciphertext, err := seal(plaintext, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(ciphertext, []byte("secret-token")) {
+		t.Error("the ciphertext contains the plaintext")
+	}
+	if enc.Cipher != "aes-256-gcm" || enc.KDF != "scrypt" || len(enc.Salt) != saltLength || len(enc.Nonce) != 12 {
+		t.Errorf("got encryption %+v, want AES-256-GCM with scrypt, a %d byte salt and a 12 byte nonce", enc, saltLength)
+	}
+	got, err := open(enc, ciphertext, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("got %s, want %s", got, plaintext)
+	}
+
+	// Every seal has its own salt and nonce
+	again, _, err := seal(plaintext, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(again.Salt, enc.Salt) || bytes.Equal(again.Nonce, enc.Nonce) {
+		t.Error("two seals share a salt or nonce")
+	}
+}
+
+func TestOpenRejectsWrongPassphraseAndTampering(t *testing.T) {
+	enc, ciphertext, err := seal([]byte(`{"default":"secret-token"}`), []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	flip := func(b []byte) []byte {
+		b = bytes.Clone(b)
+		b[len(b)/2] ^= 1
+		return b
+	}
+	tests := []struct {
+		name       string
+		enc        encryption
+		ciphertext []byte
+		passphrase string
+	}{
+		{"wrong passphrase", *enc, ciphertext, "Passphrase"},
+		{"empty passphrase", *enc, ciphertext, ""},
+		{"tampered ciphertext", *enc, flip(ciphertext), "passphrase"},
+		{"truncated ciphertext", *enc, ciphertext[:len(ciphertext)-1], "passphrase"},
+		{"tampered salt", encryption{Cipher: enc.Cipher, KDF: enc.KDF, N: enc.N, R: enc.R, P: enc.P, Salt: flip(enc.Salt), Nonce: enc.Nonce}, ciphertext, "passphrase"},
+		{"tampered nonce", encryption{Cipher: enc.Cipher, KDF: enc.KDF, N: enc.N, R: enc.R, P: enc.P, Salt: enc.Salt, Nonce: flip(enc.Nonce)}, ciphertext, "passphrase"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := open(&tt.enc, tt.ciphertext, []byte(tt.passphrase))
+			if !errors.Is(err, ErrWrongPassphrase) || got != nil {
+				t.Errorf("got %q, %v, want ErrWrongPassphrase", got, err)
+			}
+		})
+	}
+}
+
+func TestOpenRejectsUnsupportedEncryption(t *testing.T) {
+	enc, ciphertext, err := seal([]byte("{}"), []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		edit func(*encryption)
+		want string
+	}{
+		{"cipher", func(e *encryption) { e.Cipher = "aes-128-cbc" }, "unsupported credentials encryption"},
+		{"kdf", func(e *encryption) { e.KDF = "pbkdf2" }, "unsupported credentials encryption"},
+		{"N not a power of two", func(e *encryption) { e.N = scryptN + 1 }, "unsupported scrypt parameters"},
+		{"N too small", func(e *encryption) { e.N = 1 }, "unsupported scrypt parameters"},
+		{"N too large", func(e *encryption) { e.N = 16 * scryptN }, "unsupported scrypt parameters"},
+		{"r zero", func(e *encryption) { e.R = 0 }, "unsupported scrypt parameters"},
+		{"r too large", func(e *encryption) { e.R = 16 * scryptR }, "unsupported scrypt parameters"},
+		{"p too large", func(e *encryption) { e.P = 1 << 30 }, "unsupported scrypt parameters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edited := *enc
+			tt.edit(&edited)
+			if _, err := open(&edited, ciphertext, []byte("passphrase")); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want %s", err, tt.want)
+			}
+		})
+	}
+
+	// Up to eight times the default cost is accepted
+	cheap := encryption{N: 8 * scryptN, R: scryptR, P: scryptP}
+	if err := cheap.checkCost(); err != nil {
+		t.Errorf("got %v for N=%d, want it accepted", err, cheap.N)
+	}
+}
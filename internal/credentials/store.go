@@ -0,0 +1,259 @@
+// Package credentials stores API tokens outside the config file.
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// Store keeps one API token per profile.
+type Store interface {
+	// Name identifies the backend in messages, e.g. "file (encrypted)".
+	Name() string
+	// Get returns the token of profile, or "" when none is stored.
+	Get(profile string) (string, error)
+	// Has reports whether a token is stored for profile without decrypting it.
+	Has(profile string) (bool, error)
+	Set(profile, token string) error
+	Delete(profile string) error
+}
+
+const fileVersion = 1
+
+// fileContents is the on-disk format of a FileStore. Exactly one of Tokens and
+// Ciphertext is set. Profiles lists the profile names of an encrypted file so
+// Has works without the passphrase.
+type fileContents struct {
+	Version    int               `json:"version"`
+	Tokens     map[string]string `json:"tokens,omitempty"`
+	Profiles   []string          `json:"profiles,omitempty"`
+	Encryption *encryption       `json:"encryption,omitempty"`
+	Ciphertext []byte            `json:"ciphertext,omitempty"`
+}
+
+// FileStore keeps tokens in a JSON file that only its owner can read. When
+// Encrypt is set the tokens are sealed with a key derived from the passphrase.
+// A file that is already encrypted stays encrypted until Rewrite is called
+// without Encrypt.
+type FileStore struct {
+	Path    string
+	Encrypt bool
+	// Passphrase returns the passphrase of encrypted files. Together with
+	// NewPassphrase it is called at most once per FileStore.
+	Passphrase func() ([]byte, error)
+	// NewPassphrase returns the passphrase a file that is not encrypted yet
+	// is encrypted with, e.g. asking for it twice. Passphrase is used when it
+	// is nil.
+	NewPassphrase func() ([]byte, error)
+
+	once       sync.Once
+	passphrase []byte
+	passErr    error
+}
+
+// DefaultPath returns ~/.virak-cli/credentials.json.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".virak-cli", "credentials.json"), nil
+}
+
+// Name reports whether the file on disk is encrypted, or, when there is no
+// file yet, whether it will be.
+func (s *FileStore) Name() string {
+	encrypted := s.Encrypt
+	if _, err := os.Stat(s.Path); err == nil {
+		encrypted, _ = s.Encrypted()
+	}
+	if encrypted {
+		return "file (encrypted)"
+	}
+	return "file"
+}
+
+// Encrypted reports whether the file on disk is encrypted. A missing file is
+// not.
+func (s *FileStore) Encrypted() (bool, error) {
+	contents, err := s.read()
+	if err != nil {
+		return false, err
+	}
+	return contents.Encryption != nil, nil
+}
+
+// Rewrite writes the stored tokens again, encrypted when Encrypt is set and in
+// plain text otherwise. It turns the encryption of an existing file on or off.
+func (s *FileStore) Rewrite() error {
+	contents, tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	if contents.Encryption == nil && len(tokens) == 0 {
+		return nil
+	}
+	return s.save(tokens, s.Encrypt, contents.Encryption == nil)
+}
+
+func (s *FileStore) Get(profile string) (string, error) {
+	_, tokens, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	return tokens[profile], nil
+}
+
+func (s *FileStore) Has(profile string) (bool, error) {
+	contents, err := s.read()
+	if err != nil {
+		return false, err
+	}
+	if contents.Encryption != nil {
+		return slices.Contains(contents.Profiles, profile), nil
+	}
+	return contents.Tokens[profile] != "", nil
+}
+
+func (s *FileStore) Set(profile, token string) error {
+	contents, tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	tokens[profile] = token
+	return s.update(contents, tokens)
+}
+
+func (s *FileStore) Delete(profile string) error {
+	contents, err := s.read()
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(contents.Profiles, profile) && contents.Tokens[profile] == "" {
+		return nil
+	}
+	contents, tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	delete(tokens, profile)
+	return s.update(contents, tokens)
+}
+
+// read parses the file without decrypting it. A missing file is empty.
+func (s *FileStore) read() (*fileContents, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &fileContents{Version: fileVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials: %w", err)
+	}
+	var contents fileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s: %w", s.Path, err)
+	}
+	if contents.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported credentials file version %d in %s", contents.Version, s.Path)
+	}
+	return &contents, nil
+}
+
+// load returns the file as read and all tokens, decrypting them if needed.
+func (s *FileStore) load() (*fileContents, map[string]string, error) {
+	contents, err := s.read()
+	if err != nil {
+		return nil, nil, err
+	}
+	if contents.Encryption == nil {
+		if contents.Tokens == nil {
+			return contents, map[string]string{}, nil
+		}
+		return contents, contents.Tokens, nil
+	}
+
+	passphrase, err := s.getPassphrase(false)
+	if err != nil {
+		return nil, nil, err
+	}
+	plaintext, err := open(contents.Encryption, contents.Ciphertext, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokens := map[string]string{}
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse decrypted credentials: %w", err)
+	}
+	return contents, tokens, nil
+}
+
+// update saves tokens after a change to the file read as contents. An
+// encrypted file stays encrypted even without Encrypt, so tokens are never
+// written in plain text behind the user's back.
+func (s *FileStore) update(contents *fileContents, tokens map[string]string) error {
+	encrypted := contents.Encryption != nil
+	return s.save(tokens, s.Encrypt || encrypted, !encrypted)
+}
+
+// save writes tokens, encrypted when encrypt is set. newPassphrase tells that
+// the file is not encrypted yet, so the passphrase is a new one.
+func (s *FileStore) save(tokens map[string]string, encrypt, newPassphrase bool) error {
+	contents := fileContents{Version: fileVersion}
+	if encrypt {
+		passphrase, err := s.getPassphrase(newPassphrase)
+		if err != nil {
+			return err
+		}
+		plaintext, err := json.Marshal(tokens)
+		if err != nil {
+			return fmt.Errorf("failed to encode credentials: %w", err)
+		}
+		contents.Encryption, contents.Ciphertext, err = seal(plaintext, passphrase)
+		if err != nil {
+			return err
+		}
+		for profile := range tokens {
+			contents.Profiles = append(contents.Profiles, profile)
+		}
+		slices.Sort(contents.Profiles)
+	} else {
+		contents.Tokens = tokens
+	}
+
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+	if err := os.WriteFile(s.Path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Chmod(s.Path, 0600); err != nil {
+		return fmt.Errorf("failed to restrict credentials file permissions: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) getPassphrase(isNew bool) ([]byte, error) {
+	s.once.Do(func() {
+		passphrase := s.Passphrase
+		if isNew && s.NewPassphrase != nil {
+			passphrase = s.NewPassphrase
+		}
+		if passphrase == nil {
+			s.passErr = errors.New("credentials are encrypted but no passphrase is available")
+			return
+		}
+		s.passphrase, s.passErr = passphrase()
+	})
+	return s.passphrase, s.passErr
+}
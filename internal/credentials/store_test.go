@@ -0,0 +1,216 @@
+package credentials
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// passphrase returns a Passphrase func for s that counts its calls.
+func passphrase(s string, calls *int) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		*calls++
+		return []byte(s), nil
+	}
+}
+
+// noPassphrase fails the test when the store asks for a passphrase.
+func noPassphrase(t *testing.T) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		t.Error("the store asked for the passphrase")
+		return nil, errors.New("no passphrase")
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func checkMode(t *testing.T, path string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("got mode %o, want 600", mode)
+	}
+}
+
+func TestFileStorePlaintext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "credentials.json")
+	s := &FileStore{Path: path, Passphrase: noPassphrase(t)}
+
+	if token, err := s.Get("default"); err != nil || token != "" {
+		t.Fatalf("got %q, %v from a missing file, want no token", token, err)
+	}
+	if err := s.Set("default", "token-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("staging", "token-2"); err != nil {
+		t.Fatal(err)
+	}
+	checkMode(t, path)
+	if s.Name() != "file" {
+		t.Errorf("got name %q, want file", s.Name())
+	}
+	if token, err := s.Get("staging"); err != nil || token != "token-2" {
+		t.Errorf("got %q, %v, want token-2", token, err)
+	}
+	if ok, err := s.Has("default"); err != nil || !ok {
+		t.Errorf("got %t, %v, want the default profile", ok, err)
+	}
+
+	if err := s.Delete("default"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := s.Has("default"); ok {
+		t.Error("the deleted token is still there")
+	}
+	if strings.Contains(readFile(t, path), "token-1") {
+		t.Error("the deleted token is still in the file")
+	}
+}
+
+func TestFileStoreRestrictsMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows has no permission bits")
+	}
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(`{"version": 1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s := &FileStore{Path: path}
+	if err := s.Set("default", "token"); err != nil {
+		t.Fatal(err)
+	}
+	checkMode(t, path)
+}
+
+func TestFileStoreEncrypted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	calls, newCalls := 0, 0
+	s := &FileStore{Path: path, Encrypt: true, Passphrase: passphrase("pass", &calls), NewPassphrase: passphrase("pass", &newCalls)}
+	if err := s.Set("default", "secret-token"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("staging", "other-token"); err != nil {
+		t.Fatal(err)
+	}
+	if calls+newCalls != 1 || newCalls != 1 {
+		t.Errorf("asked for the passphrase %d times and the new one %d times, want the new one once", calls, newCalls)
+	}
+	checkMode(t, path)
+	if data := readFile(t, path); strings.Contains(data, "secret-token") || strings.Contains(data, "other-token") {
+		t.Errorf("the encrypted file contains a token:\n%s", data)
+	}
+
+	// Has and Name work without the passphrase
+	locked := &FileStore{Path: path, Passphrase: noPassphrase(t)}
+	if ok, err := locked.Has("staging"); err != nil || !ok {
+		t.Errorf("got %t, %v, want the staging profile", ok, err)
+	}
+	if ok, _ := locked.Has("prod"); ok {
+		t.Error("got a token for a profile without one")
+	}
+	if locked.Name() != "file (encrypted)" {
+		t.Errorf("got name %q, want file (encrypted)", locked.Name())
+	}
+
+	opened := &FileStore{Path: path, Passphrase: passphrase("pass", &calls)}
+	if token, err := opened.Get("default"); err != nil || token != "secret-token" {
+		t.Errorf("got %q, %v, want secret-token", token, err)
+	}
+
+	wrong := &FileStore{Path: path, Passphrase: passphrase("Pass", &calls)}
+	if token, err := wrong.Get("default"); !errors.Is(err, ErrWrongPassphrase) || token != "" {
+		t.Errorf("got %q, %v, want ErrWrongPassphrase", token, err)
+	}
+	none := &FileStore{Path: path}
+	if _, err := none.Get("default"); err == nil {
+		t.Error("got no error without a passphrase")
+	}
+
+	// An encrypted file stays encrypted when changed without Encrypt
+	if err := opened.Set("prod", "prod-token"); err != nil {
+		t.Fatal(err)
+	}
+	if data := readFile(t, path); strings.Contains(data, "prod-token") || strings.Contains(data, "secret-token") {
+		t.Errorf("a change wrote tokens in plain text:\n%s", data)
+	}
+	if err := opened.Delete("staging"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := locked.Has("staging"); ok {
+		t.Error("the deleted profile is still listed")
+	}
+}
+
+func TestFileStoreRewrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	plain := &FileStore{Path: path, Passphrase: noPassphrase(t)}
+	if err := plain.Set("default", "secret-token"); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	encrypt := &FileStore{Path: path, Encrypt: true, NewPassphrase: passphrase("pass", &calls), Passphrase: noPassphrase(t)}
+	if err := encrypt.Rewrite(); err != nil {
+		t.Fatal(err)
+	}
+	if encrypted, err := encrypt.Encrypted(); err != nil || !encrypted || calls != 1 {
+		t.Fatalf("got encrypted %t, %v after %d new passphrases, want the file encrypted with a new one", encrypted, err, calls)
+	}
+	if strings.Contains(readFile(t, path), "secret-token") {
+		t.Error("the encrypted file contains the token")
+	}
+
+	decrypt := &FileStore{Path: path, Passphrase: passphrase("pass", &calls)}
+	if err := decrypt.Rewrite(); err != nil {
+		t.Fatal(err)
+	}
+	if encrypted, err := decrypt.Encrypted(); err != nil || encrypted {
+		t.Fatalf("got encrypted %t, %v, want the file in plain text", encrypted, err)
+	}
+	checkMode(t, path)
+	if token, err := plain.Get("default"); err != nil || token != "secret-token" {
+		t.Errorf("got %q, %v after decrypting, want secret-token", token, err)
+	}
+
+	again := &FileStore{Path: path, Encrypt: true, NewPassphrase: passphrase("new-pass", &calls)}
+	if err := again.Rewrite(); err != nil {
+		t.Fatal(err)
+	}
+	reopened := &FileStore{Path: path, Passphrase: passphrase("new-pass", &calls)}
+	if token, err := reopened.Get("default"); err != nil || token != "secret-token" {
+		t.Errorf("got %q, %v after encrypting again, want secret-token", token, err)
+	}
+
+	// Without tokens there is nothing to encrypt and no passphrase to ask for
+	empty := &FileStore{Path: filepath.Join(t.TempDir(), "credentials.json"), Encrypt: true, NewPassphrase: noPassphrase(t)}
+	if err := empty.Rewrite(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileStoreVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(`{"version": 2}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	s := &FileStore{Path: path}
+	if _, err := s.Get("default"); err == nil || !strings.Contains(err.Error(), "unsupported credentials file version 2") {
+		t.Errorf("got error %v, want an unsupported version", err)
+	}
+}
@@ -1,30 +1,109 @@
 package logger
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"path/filepath"
 )
 
-func InitLogger() {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		slog.Error("failed to get user home directory", "error", err)
-		os.Exit(1)
-	}
+var (
+	// logFile is the app.log opened by InitLogger, if any.
+	logFile *os.File
+	// stderrHandler writes records to stderr in debug mode.
+	stderrHandler slog.Handler
+)
 
-	logDir := filepath.Join(home, ".virak-cli", "logs")
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		slog.Error("failed to create log directory", "error", err)
-		os.Exit(1)
+// InitLogger configures the default slog logger. Records are written to
+// app.log in logDir unless logDir is empty. In debug mode the level is lowered
+// to debug and records are also written to stderr.
+func InitLogger(logDir string, debug bool) {
+	level := slog.LevelInfo
+	if debug {
+		level = slog.LevelDebug
 	}
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handlers []slog.Handler
+	if logDir != "" {
+		// Logs may name accounts and resources, keep them private
+		if err := os.MkdirAll(logDir, 0700); err != nil {
+			slog.Error("failed to create log directory", "error", err)
+			os.Exit(1)
+		}
 
-	logFile, err := os.OpenFile(filepath.Join(logDir, "app.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-	if err != nil {
-		slog.Error("failed to open log file", "error", err)
-		os.Exit(1)
+		file, err := os.OpenFile(filepath.Join(logDir, "app.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			slog.Error("failed to open log file", "error", err)
+			os.Exit(1)
+		}
+		logFile = file
+		handlers = append(handlers, slog.NewJSONHandler(logFile, opts))
+	}
+	if debug {
+		stderrHandler = slog.NewTextHandler(os.Stderr, opts)
+		handlers = append(handlers, stderrHandler)
+	}
+	if len(handlers) == 0 {
+		return
 	}
 
-	logger := slog.New(slog.NewJSONHandler(logFile, nil))
+	logger := slog.New(fanoutHandler(handlers))
 	slog.SetDefault(logger)
 }
+
+// CloseLogFile stops logging to app.log and closes it, so its directory can be
+// removed, which Windows refuses while the file is open. Records still go to
+// stderr in debug mode.
+func CloseLogFile() error {
+	if logFile == nil {
+		return nil
+	}
+	handler := stderrHandler
+	if handler == nil {
+		handler = slog.DiscardHandler
+	}
+	slog.SetDefault(slog.New(handler))
+	err := logFile.Close()
+	logFile = nil
+	return err
+}
+
+// fanoutHandler forwards every record to all of its handlers.
+type fanoutHandler []slog.Handler
+
+func (h fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, r.Level) {
+			errs = append(errs, handler.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		out[i] = handler.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (h fanoutHandler) WithGroup(name string) slog.Handler {
+	out := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		out[i] = handler.WithGroup(name)
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"slices"
+	"strings"
+)
+
+// Whoami describes the identity behind the active profile's token.
+type Whoami struct {
+	Profile     string   `json:"profile"`
+	TokenSource string   `json:"token_source"`
+	APIURL      string   `json:"api_url"`
+	UserName    string   `json:"user_name"`
+	UserEmail   string   `json:"user_email"`
+	ZoneID      string   `json:"zone_id"`
+	ZoneName    string   `json:"zone_name"`
+	Abilities   []string `json:"abilities"`
+}
+
+func RenderWhoami(w Whoami) error {
+	user := w.UserName
+	if w.UserEmail != "" {
+		user += " <" + w.UserEmail + ">"
+	}
+	abilities := slices.Clone(w.Abilities)
+	slices.Sort(abilities)
+	apiURL := w.APIURL
+	if apiURL == "" {
+		apiURL = "(default)"
+	}
+	zone := w.ZoneID
+	if w.ZoneName != "" {
+		zone = w.ZoneName + " (" + w.ZoneID + ")"
+	}
+	if zone == "" {
+		zone = "(none)"
+	}
+
+	separator := "\n"
+	if !Tabular() {
+		separator = " "
+	}
+	table := NewTable("Field", "Value")
+	table.Append("Profile", w.Profile)
+	table.Append("User", user)
+	table.Append("Token Source", w.TokenSource)
+	table.Append("API URL", apiURL)
+	table.Append("Default Zone", zone)
+	table.Append("Abilities", strings.Join(abilities, separator))
+	return Output(w, table)
+}
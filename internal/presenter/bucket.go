@@ -2,57 +2,46 @@ package presenter
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/virak-cloud/cli/pkg/http/responses"
-
-	"github.com/olekukonko/tablewriter"
 )
 
-func RenderBucketList(buckets []responses.ObjectStorageBucket) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "URL", "Region", "Status", "Policy", "Size"})
-
-	for _, bucket := range buckets {
-		table.Append([]string{
-			bucket.ID,
-			bucket.Name,
-			bucket.URL,
-			bucket.Region,
-			bucket.Status,
-			bucket.Policy,
-			fmt.Sprintf("%d", bucket.Size),
-		})
-	}
-	table.Render()
+// BucketColumns are the columns of bucket list.
+var BucketColumns = []Column[responses.ObjectStorageBucket]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "url", Header: "URL"},
+	{Path: "region", Header: "Region"},
+	{Path: "status", Header: "Status"},
+	{Path: "policy", Header: "Policy"},
+	{Path: "size", Header: "Size"},
 }
 
-func RenderBucketDetail(bucket responses.ObjectStorageBucket) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Field", "Value"})
-	table.Append([]string{"ID", bucket.ID})
-	table.Append([]string{"Name", bucket.Name})
-	table.Append([]string{"URL", bucket.URL})
-	table.Append([]string{"Region", bucket.Region})
-	table.Append([]string{"Access Key", bucket.AccessKey})
-	table.Append([]string{"Secret Key", bucket.SecretKey})
-	table.Append([]string{"Status", bucket.Status})
-	table.Append([]string{"Policy", bucket.Policy})
-	table.Append([]string{"Size", fmt.Sprintf("%d", bucket.Size)})
-	table.Append([]string{"Created At", fmt.Sprintf("%d", bucket.CreatedAt)})
-	table.Append([]string{"Updated At", fmt.Sprintf("%d", bucket.UpdatedAt)})
-	table.Append([]string{"Tier", bucket.Tier})
-	table.Append([]string{"Is Failed", fmt.Sprintf("%t", bucket.IsFailed)})
-	table.Append([]string{"Message", bucket.Message})
-	table.Render()
+func RenderBucketDetail(resp *responses.ObjectStorageBucketResponse) error {
+	bucket := resp.Data
+	table := NewTable("Field", "Value")
+	table.Append("ID", bucket.ID)
+	table.Append("Name", bucket.Name)
+	table.Append("URL", bucket.URL)
+	table.Append("Region", bucket.Region)
+	table.Append("Access Key", bucket.AccessKey)
+	table.Append("Secret Key", bucket.SecretKey)
+	table.Append("Status", bucket.Status)
+	table.Append("Policy", bucket.Policy)
+	table.Append("Size", fmt.Sprintf("%d", bucket.Size))
+	table.Append("Created At", fmt.Sprintf("%d", bucket.CreatedAt))
+	table.Append("Updated At", fmt.Sprintf("%d", bucket.UpdatedAt))
+	table.Append("Tier", bucket.Tier)
+	table.Append("Is Failed", fmt.Sprintf("%t", bucket.IsFailed))
+	table.Append("Message", bucket.Message)
+	return Output(resp, table)
 }
 
-func RenderBucketEvents(events []responses.ObjectStorageEvent) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Bucket ID", "Source", "Type", "Content", "Created At"})
-
-	for _, event := range events {
-		table.Append([]string{event.ProductID, event.ProductSource, event.Type, event.Content, fmt.Sprintf("%d", event.CreatedAt)})
-	}
-	table.Render()
+// BucketEventColumns are the columns of bucket events.
+var BucketEventColumns = []Column[responses.ObjectStorageEvent]{
+	{Path: "product_id", Header: "Bucket ID"},
+	{Path: "product_source", Header: "Source"},
+	{Path: "type", Header: "Type"},
+	{Path: "content", Header: "Content"},
+	{Path: "created_at", Header: "Created At"},
 }
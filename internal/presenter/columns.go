@@ -0,0 +1,173 @@
+package presenter
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// field is a column of the registry: a value reached from a response struct
+// through the json names in path, e.g. service_offering.hardware.cpu_core.
+type field struct {
+	path  string
+	index [][]int
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[reflect.Type][]field{}
+)
+
+// fieldsOf returns the columns of t, one per leaf of the struct in the order
+// of its fields. Nested structs and pointers to them are walked; slices and
+// maps are single columns.
+func fieldsOf(t reflect.Type) []field {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if fields, ok := registry[t]; ok {
+		return fields
+	}
+	fields := collectFields(t, "", nil, map[reflect.Type]bool{})
+	registry[t] = fields
+	return fields
+}
+
+func collectFields(t reflect.Type, prefix string, index [][]int, seen map[reflect.Type]bool) []field {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return nil
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	var fields []field
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		at := append(append([][]int{}, index...), f.Index)
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct {
+			if nested := collectFields(ft, path, at, seen); len(nested) > 0 {
+				fields = append(fields, nested...)
+				continue
+			}
+		}
+		fields = append(fields, field{path: path, index: at})
+	}
+	return fields
+}
+
+// jsonName returns the name encoding/json uses for f, and false for fields
+// it skips.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+// lookupField returns the column of t at path.
+func lookupField(t reflect.Type, path string) (field, bool) {
+	for _, f := range fieldsOf(t) {
+		if f.path == path {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+// text returns the value of f in item as shown in tables. Nil pointers on
+// the way are empty.
+func (f field) text(item reflect.Value) string {
+	v := item
+	for _, index := range f.index {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return ""
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByIndex(index)
+	}
+	return valueText(v)
+}
+
+func valueText(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		if scalars := scalarElems(v); scalars != nil {
+			return strings.Join(scalars, ", ")
+		}
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+// scalarElems returns the text of the elements of a slice of scalars, and nil
+// for other slices.
+func scalarElems(v reflect.Value) []string {
+	elem := v.Type().Elem()
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	switch elem.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+		return nil
+	}
+	elems := make([]string, v.Len())
+	for i := range elems {
+		elems[i] = valueText(v.Index(i))
+	}
+	return elems
+}
+
+// columnPaths returns the paths of t sorted by name.
+func columnPaths(t reflect.Type) []string {
+	var paths []string
+	for _, f := range fieldsOf(t) {
+		paths = append(paths, f.path)
+	}
+	sort.Strings(paths)
+	return paths
+}
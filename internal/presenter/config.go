@@ -0,0 +1,22 @@
+package presenter
+
+// ConfigEntry is one row of 'virak-cli config list'.
+type ConfigEntry struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+	Secret      bool   `json:"-"`
+}
+
+func RenderConfigList(entries []ConfigEntry) error {
+	table := NewTable("Key", "Value", "Description")
+	masked := make([]ConfigEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.Secret && e.Value != "" {
+			e.Value = maskToken(e.Value)
+		}
+		masked = append(masked, e)
+		table.Append(e.Key, e.Value, e.Description)
+	}
+	return Output(masked, table)
+}
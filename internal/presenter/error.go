@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/virak-cloud/cli/internal/cli"
+	"github.com/virak-cloud/cli/pkg/http"
+)
+
+// ErrorOutput is what a failed command writes to stderr in the JSON and YAML
+// formats.
+type ErrorOutput struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail describes the error. Kind names ExitCode, see cli.ErrorKind.
+// The API fields are set when the API answered with an error.
+type ErrorDetail struct {
+	Kind       string              `json:"kind"`
+	ExitCode   int                 `json:"exit_code"`
+	Message    string              `json:"message"`
+	StatusCode int                 `json:"status_code,omitempty"`
+	Method     string              `json:"method,omitempty"`
+	URL        string              `json:"url,omitempty"`
+	Fields     map[string][]string `json:"fields,omitempty"`
+}
+
+// Error reports err, which ends the CLI with exit code, on stderr: as an
+// ErrorOutput in the JSON and YAML formats, so scripts can parse it, and as
+// "Error: " and the message in the others.
+func Error(err error, code int) {
+	switch Format() {
+	case FormatJSON, FormatYAML:
+		detail := ErrorDetail{
+			Kind:     cli.ErrorKind(code),
+			ExitCode: code,
+			Message:  err.Error(),
+		}
+		if apiErr, ok := http.AsAPIError(err); ok {
+			detail.StatusCode = apiErr.StatusCode
+			detail.Method = apiErr.Method
+			detail.URL = apiErr.URL
+			detail.Fields = apiErr.Errors
+		}
+		if werr := write(os.Stderr, Format(), ErrorOutput{Error: detail}, nil); werr == nil {
+			return
+		}
+	}
+	fmt.Fprintln(os.Stderr, "Error:", err)
+}
@@ -2,103 +2,92 @@ package presenter
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
 // RenderWallet displays wallet balance information in a key-value format
-func RenderWallet(wallet responses.WalletsBalanceResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Field", "Value"})
-	table.Append([]string{"Name", wallet.Data.Name})
-	table.Append([]string{"Track", wallet.Data.Track})
-	table.Append([]string{"Type", wallet.Data.Type})
-	table.Append([]string{"Balance", fmt.Sprintf("%.2f", wallet.Data.Balance)})
-	table.Append([]string{"Balance Limit", fmt.Sprintf("%.2f", wallet.Data.BalanceLimit)})
-	table.Append([]string{"Is Blocked", fmt.Sprintf("%t", wallet.Data.IsBlocked)})
-	table.Append([]string{"Max Cost", fmt.Sprintf("%.2f", wallet.Data.MaxCost)})
-	table.Append([]string{"Remaining Hours", fmt.Sprintf("%.2f", wallet.Data.RemainingHours)})
-	table.Append([]string{"Updated At", wallet.Data.UpdatedAt})
-	table.Render()
+func RenderWallet(wallet *responses.WalletsBalanceResponse) error {
+	table := NewTable("Field", "Value")
+	table.Append("Name", wallet.Data.Name)
+	table.Append("Track", wallet.Data.Track)
+	table.Append("Type", wallet.Data.Type)
+	table.Append("Balance", fmt.Sprintf("%.2f", wallet.Data.Balance))
+	table.Append("Balance Limit", fmt.Sprintf("%.2f", wallet.Data.BalanceLimit))
+	table.Append("Is Blocked", fmt.Sprintf("%t", wallet.Data.IsBlocked))
+	table.Append("Max Cost", fmt.Sprintf("%.2f", wallet.Data.MaxCost))
+	table.Append("Remaining Hours", fmt.Sprintf("%.2f", wallet.Data.RemainingHours))
+	table.Append("Updated At", wallet.Data.UpdatedAt)
+	return Output(wallet, table)
 }
 
 // RenderCostDocuments displays cost documents in a table format
-func RenderCostDocuments(documents []responses.CostDocument) {
-	if len(documents) == 0 {
-		fmt.Println("No cost documents found.")
-		return
-	}
+// CostDocumentColumns are the columns of finance documents.
+var CostDocumentColumns = []Column[responses.CostDocument]{
+	{Path: "period", Header: "Period", Value: func(doc responses.CostDocument) string {
+		return fmt.Sprintf("%s to %s", doc.DateFrom, doc.DateTo)
+	}},
+	{Path: "Instance", Header: "Instance", Value: cost(func(doc responses.CostDocument) float64 { return doc.Instance })},
+	{Path: "NetworkNetflow", Header: "Network", Value: cost(func(doc responses.CostDocument) float64 { return doc.NetworkNetflow })},
+	{Path: "InstanceSnapshot", Header: "Snapshots", Value: cost(func(doc responses.CostDocument) float64 { return doc.InstanceSnapshot })},
+	{Path: "InstanceDataVolumes", Header: "Volumes", Value: cost(func(doc responses.CostDocument) float64 { return doc.InstanceDataVolumes })},
+	{Path: "SupportOfferings", Header: "Support", Value: cost(func(doc responses.CostDocument) float64 { return doc.SupportOfferings })},
+	{Path: "NetworkInternetPublicAddressV4", Header: "Public IP", Value: cost(func(doc responses.CostDocument) float64 { return doc.NetworkInternetPublicAddressV4 })},
+	{Path: "NetworkDevice", Header: "Device", Value: cost(func(doc responses.CostDocument) float64 { return doc.NetworkDevice })},
+	{Path: "BucketSize", Header: "Bucket Size", Value: cost(func(doc responses.CostDocument) float64 { return doc.BucketSize })},
+	{Path: "bucket_traffic", Header: "Bucket Traffic", Value: cost(func(doc responses.CostDocument) float64 { return doc.BucketDownloadTraffic + doc.BucketUploadTraffic })},
+	{Path: "KubernetesNode", Header: "Kubernetes", Value: cost(func(doc responses.CostDocument) float64 { return doc.KubernetesNode })},
+}
 
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Period", "Instance", "Network", "Snapshots", "Volumes", "Support", "Public IP", "Device", "Bucket Size", "Bucket Traffic", "Kubernetes"})
-
-	for _, doc := range documents {
-		period := fmt.Sprintf("%s to %s", doc.DateFrom, doc.DateTo)
-		table.Append([]string{
-			period,
-			fmt.Sprintf("%.2f", doc.Instance),
-			fmt.Sprintf("%.2f", doc.NetworkNetflow),
-			fmt.Sprintf("%.2f", doc.InstanceSnapshot),
-			fmt.Sprintf("%.2f", doc.InstanceDataVolumes),
-			fmt.Sprintf("%.2f", doc.SupportOfferings),
-			fmt.Sprintf("%.2f", doc.NetworkInternetPublicAddressV4),
-			fmt.Sprintf("%.2f", doc.NetworkDevice),
-			fmt.Sprintf("%.2f", doc.BucketSize),
-			fmt.Sprintf("%.2f", doc.BucketDownloadTraffic+doc.BucketUploadTraffic),
-			fmt.Sprintf("%.2f", doc.KubernetesNode),
-		})
+// cost shows an amount of a cost document with two decimals.
+func cost(amount func(responses.CostDocument) float64) func(responses.CostDocument) string {
+	return func(doc responses.CostDocument) string {
+		return fmt.Sprintf("%.2f", amount(doc))
 	}
-	table.Render()
 }
 
 // RenderPayments displays payment history in a table format
-func RenderPayments(payments []interface{}) {
-	if len(payments) == 0 {
-		fmt.Println("No payments found.")
-		return
-	}
-
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Amount", "Driver", "Status", "Reference ID", "Created At"})
-
-	for _, payment := range payments {
-		if paymentMap, ok := payment.(map[string]interface{}); ok {
-			id := getStringValue(paymentMap, "id")
-			amountStr := getStringValue(paymentMap, "amount")
-			driver := getStringValue(paymentMap, "driver")
-			status := getStringValue(paymentMap, "status")
-			referenceID := getStringValue(paymentMap, "reference_id")
-			createdAtStr := getStringValue(paymentMap, "created_at")
-
-			// Format amount with commas
-			var formattedAmount string
-			if amount, err := strconv.ParseFloat(amountStr, 64); err == nil {
-				formattedAmount = formatWithCommas(amount)
-			} else {
-				formattedAmount = amountStr
-			}
+// PaymentColumns are the columns of finance payments. The API returns
+// payments as untyped objects, so every column reads a key of one.
+var PaymentColumns = []Column[any]{
+	{Path: "id", Header: "ID", Value: paymentField("id")},
+	{Path: "amount", Header: "Amount", Value: func(payment any) string {
+		// Format amount with commas
+		amountStr := paymentField("amount")(payment)
+		if amount, err := strconv.ParseFloat(amountStr, 64); err == nil {
+			return formatWithCommas(amount)
+		}
+		return amountStr
+	}},
+	{Path: "driver", Header: "Driver", Value: paymentField("driver")},
+	{Path: "status", Header: "Status", Value: paymentField("status")},
+	{Path: "reference_id", Header: "Reference ID", Value: paymentField("reference_id")},
+	{Path: "created_at", Header: "Created At", Value: func(payment any) string {
+		// Format created_at as human readable
+		createdAtStr := paymentField("created_at")(payment)
+		if timestamp, err := strconv.ParseFloat(createdAtStr, 64); err == nil {
+			return time.Unix(int64(timestamp), 0).Format("2006-01-02 15:04:05")
+		}
+		return createdAtStr
+	}},
+}
 
-			// Format created_at as human readable
-			var formattedTime string
-			if timestamp, err := strconv.ParseFloat(createdAtStr, 64); err == nil {
-				t := time.Unix(int64(timestamp), 0)
-				formattedTime = t.Format("2006-01-02 15:04:05")
-			} else {
-				formattedTime = createdAtStr
+// paymentField reads key of a payment, or shows the whole payment in the ID
+// column when it is not an object.
+func paymentField(key string) func(any) string {
+	return func(payment any) string {
+		paymentMap, ok := payment.(map[string]interface{})
+		if !ok {
+			if key == "id" {
+				return fmt.Sprintf("%+v", payment)
 			}
-
-			table.Append([]string{id, formattedAmount, driver, status, referenceID, formattedTime})
-		} else {
-			// Fallback to original format if it's not a map
-			table.Append([]string{fmt.Sprintf("%+v", payment), "", "", "", "", ""})
+			return ""
 		}
+		return getStringValue(paymentMap, key)
 	}
-	table.Render()
 }
 
 // Helper function to safely get string values from map
@@ -134,27 +123,17 @@ func formatWithCommas(num float64) string {
 }
 
 // RenderExpenses displays expenses in a table format
-func RenderExpenses(expenses []responses.Expense) {
-	if len(expenses) == 0 {
-		fmt.Println("No expenses found.")
-		return
-	}
-
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Date", "Type", "Description", "Amount", "Status", "Created At"})
-
-	for _, expense := range expenses {
-		table.Append([]string{
-			expense.ID,
-			expense.Date,
-			expense.Type,
-			expense.Description,
-			fmt.Sprintf("%.2f", expense.Amount),
-			expense.Status,
-			expense.CreatedAt,
-		})
-	}
-	table.Render()
+// ExpenseColumns are the columns of finance expenses.
+var ExpenseColumns = []Column[responses.Expense]{
+	{Path: "id", Header: "ID"},
+	{Path: "date", Header: "Date"},
+	{Path: "type", Header: "Type"},
+	{Path: "description", Header: "Description"},
+	{Path: "amount", Header: "Amount", Value: func(expense responses.Expense) string {
+		return fmt.Sprintf("%.2f", expense.Amount)
+	}},
+	{Path: "status", Header: "Status"},
+	{Path: "created_at", Header: "Created At"},
 }
 
 // FormatCurrency formats a float64 amount to a currency string
@@ -0,0 +1,312 @@
+package presenter
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ListOptions are the flags every list command shares. Embed them in the
+// command's options struct so BindFlagsFromStruct declares them.
+type ListOptions struct {
+	Columns     string   `flag:"columns" usage:"Comma-separated columns to show, e.g. id,name,zone.name (see --list-columns)"`
+	ListColumns bool     `flag:"list-columns" usage:"Show the columns accepted by --columns, --sort-by and --filter"`
+	SortBy      string   `flag:"sort-by" usage:"Sort rows by a column; prefix it with - for descending order"`
+	Filter      []string `flag:"filter" usage:"Only show rows where a column matches, e.g. status=UP, name=web-*, cpu_core>=4 (repeatable)"`
+	NoHeaders   bool     `flag:"no-headers" usage:"Do not print the header row"`
+	Wide        bool     `flag:"wide" usage:"Show every top-level field in addition to the default columns"`
+}
+
+// Column is a column of a list. Path names a field of T by its json names,
+// e.g. service_offering.hardware.cpu_core; Value, when set, computes the cell
+// instead, e.g. to join several fields. Wide columns are only shown with
+// --wide or when named in --columns.
+type Column[T any] struct {
+	Path   string
+	Header string
+	Value  func(T) string
+	Wide   bool
+}
+
+// List renders the items of a list response with the columns, sorting and
+// filters selected with ListOptions.
+type List[T any] struct {
+	columns []Column[T]
+	filters []filter[T]
+	sortBy  *Column[T]
+	desc    bool
+	headers bool
+}
+
+// NewList checks the columns, sort key and filters of opts against columns,
+// the columns the command declares, and the fields of T. Without --columns
+// the columns that are not Wide are shown.
+func NewList[T any](opts ListOptions, columns []Column[T]) (*List[T], error) {
+	l := &List[T]{headers: !opts.NoHeaders}
+
+	switch {
+	case opts.Columns != "":
+		for _, path := range splitColumns(opts.Columns) {
+			col, err := column(path, columns)
+			if err != nil {
+				return nil, err
+			}
+			l.columns = append(l.columns, col)
+		}
+	case opts.Wide:
+		l.columns = append(l.columns, columns...)
+		for _, f := range fieldsOf(reflect.TypeFor[T]()) {
+			if strings.Contains(f.path, ".") || hasColumn(l.columns, f.path) {
+				continue
+			}
+			l.columns = append(l.columns, Column[T]{Path: f.path, Header: f.path})
+		}
+	default:
+		for _, col := range columns {
+			if !col.Wide {
+				l.columns = append(l.columns, col)
+			}
+		}
+	}
+
+	if key := strings.TrimPrefix(opts.SortBy, "-"); key != "" {
+		col, err := column(key, columns)
+		if err != nil {
+			return nil, err
+		}
+		l.sortBy = &col
+		l.desc = strings.HasPrefix(opts.SortBy, "-")
+	}
+
+	for _, expr := range opts.Filter {
+		f, err := parseFilter(expr, columns)
+		if err != nil {
+			return nil, err
+		}
+		l.filters = append(l.filters, f)
+	}
+	return l, nil
+}
+
+// ListColumns prints the columns a list command accepts, for --list-columns:
+// those it declares and the fields of T.
+func ListColumns[T any](columns []Column[T]) error {
+	paths := columnPaths(reflect.TypeFor[T]())
+	for _, col := range columns {
+		if !hasPath(paths, col.Path) {
+			paths = append(paths, col.Path)
+		}
+	}
+	sort.Strings(paths)
+	table := NewTable("Column", "Default")
+	for _, path := range paths {
+		def := ""
+		for _, col := range columns {
+			if col.Path == path && !col.Wide {
+				def = "yes"
+			}
+		}
+		table.Append(path, def)
+	}
+	return Output(paths, table)
+}
+
+// Output filters and sorts items, then writes v, the response they came from,
+// like Output does. JSON and YAML carry the filtered and sorted items in place
+// of the response's data.
+func (l *List[T]) Output(v any, items []T) error {
+	items = l.apply(items)
+
+	table := NewTable()
+	if l.headers {
+		for _, col := range l.columns {
+			table.Headers = append(table.Headers, col.Header)
+		}
+	}
+	for _, item := range items {
+		row := make([]string, len(l.columns))
+		for i, col := range l.columns {
+			row[i] = cell(col, item)
+		}
+		table.Append(row...)
+	}
+	return Output(withItems(v, items), table)
+}
+
+func (l *List[T]) apply(items []T) []T {
+	if len(l.filters) == 0 && l.sortBy == nil {
+		return items
+	}
+	kept := make([]T, 0, len(items))
+	for _, item := range items {
+		if l.matches(item) {
+			kept = append(kept, item)
+		}
+	}
+	if l.sortBy != nil {
+		sort.SliceStable(kept, func(i, j int) bool {
+			a, b := cell(*l.sortBy, kept[i]), cell(*l.sortBy, kept[j])
+			if l.desc {
+				return compare(b, a) < 0
+			}
+			return compare(a, b) < 0
+		})
+	}
+	return kept
+}
+
+func (l *List[T]) matches(item T) bool {
+	for _, f := range l.filters {
+		if !f.match(cell(f.column, item)) {
+			return false
+		}
+	}
+	return true
+}
+
+// withItems returns v with its field holding the items, usually Data, or v
+// itself when it is the slice, replaced by items.
+func withItems[T any](v any, items []T) any {
+	if _, ok := v.([]T); ok {
+		return items
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return v
+	}
+	copied := reflect.New(rv.Elem().Type())
+	copied.Elem().Set(rv.Elem())
+	for i := 0; i < copied.Elem().NumField(); i++ {
+		if f := copied.Elem().Field(i); f.CanSet() && f.Type() == reflect.TypeOf(items) {
+			f.Set(reflect.ValueOf(items))
+			return copied.Interface()
+		}
+	}
+	return v
+}
+
+// column returns the column at path: one the command declares, which keeps
+// its header and Value, or a field of T.
+func column[T any](path string, columns []Column[T]) (Column[T], error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), ".")
+	for _, col := range columns {
+		if col.Path == path {
+			return col, nil
+		}
+	}
+	if _, ok := lookupField(reflect.TypeFor[T](), path); ok {
+		return Column[T]{Path: path, Header: path}, nil
+	}
+	return Column[T]{}, fmt.Errorf("unknown column %q; use --list-columns to see the valid columns", path)
+}
+
+func cell[T any](col Column[T], item T) string {
+	if col.Value != nil {
+		return col.Value(item)
+	}
+	f, _ := lookupField(reflect.TypeFor[T](), col.Path)
+	return f.text(reflect.ValueOf(item))
+}
+
+func hasColumn[T any](columns []Column[T], path string) bool {
+	for _, col := range columns {
+		if col.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func splitColumns(s string) []string {
+	var columns []string
+	for _, col := range strings.Split(s, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// filterOps are the operators of --filter, two-character ones first so that
+// <= is not read as <.
+var filterOps = []string{"!=", "<=", ">=", "=", "<", ">"}
+
+type filter[T any] struct {
+	column Column[T]
+	op     string
+	value  string
+	glob   *regexp.Regexp
+}
+
+// parseFilter parses key<op>value. = and != compare case-insensitively and
+// accept the globs * and ?; <, <=, > and >= compare numbers as numbers and
+// everything else as text.
+func parseFilter[T any](expr string, columns []Column[T]) (filter[T], error) {
+	at, op := -1, ""
+	for _, candidate := range filterOps {
+		if i := strings.Index(expr, candidate); i > 0 && (at < 0 || i < at) {
+			at, op = i, candidate
+		}
+	}
+	if at < 0 {
+		return filter[T]{}, fmt.Errorf("invalid filter %q; use key=value, key!=value, key<value, key<=value, key>value or key>=value", expr)
+	}
+	col, err := column(expr[:at], columns)
+	if err != nil {
+		return filter[T]{}, err
+	}
+	f := filter[T]{column: col, op: op, value: strings.TrimSpace(expr[at+len(op):])}
+	if (op == "=" || op == "!=") && strings.ContainsAny(f.value, "*?") {
+		pattern := regexp.QuoteMeta(f.value)
+		pattern = strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(pattern)
+		f.glob = regexp.MustCompile("(?is)^" + pattern + "$")
+	}
+	return f, nil
+}
+
+func (f filter[T]) match(value string) bool {
+	switch f.op {
+	case "=", "!=":
+		equal := strings.EqualFold(value, f.value)
+		if f.glob != nil {
+			equal = f.glob.MatchString(value)
+		}
+		return equal == (f.op == "=")
+	case "<":
+		return compare(value, f.value) < 0
+	case "<=":
+		return compare(value, f.value) <= 0
+	case ">":
+		return compare(value, f.value) > 0
+	default:
+		return compare(value, f.value) >= 0
+	}
+}
+
+// compare orders numbers numerically and other values as text.
+func compare(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
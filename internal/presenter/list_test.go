@@ -0,0 +1,208 @@
+package presenter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type testHardware struct {
+	CPUCore int `json:"cpu_core"`
+	Memory  int `json:"memory_mb"`
+}
+
+type testInstance struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Status   string        `json:"status"`
+	Hardware *testHardware `json:"hardware"`
+	Tags     []string      `json:"tags"`
+	Internal string        `json:"-"`
+}
+
+type testInstanceList struct {
+	Data []testInstance `json:"data"`
+}
+
+var testInstances = []testInstance{
+	{ID: "1", Name: "web-1", Status: "UP", Hardware: &testHardware{CPUCore: 2, Memory: 2048}, Tags: []string{"a", "b"}},
+	{ID: "2", Name: "web-10", Status: "DOWN", Hardware: &testHardware{CPUCore: 8, Memory: 16384}},
+	{ID: "3", Name: "db", Status: "up", Hardware: &testHardware{CPUCore: 4, Memory: 8192}},
+	{ID: "4", Name: "new", Status: "CREATING"},
+}
+
+var testColumns = []Column[testInstance]{
+	{Path: "name", Header: "Name"},
+	{Path: "status", Header: "Status"},
+	{Path: "size", Header: "Size", Value: func(i testInstance) string {
+		if i.Hardware == nil {
+			return ""
+		}
+		return strings.Repeat("x", i.Hardware.CPUCore)
+	}},
+	{Path: "id", Header: "ID", Wide: true},
+}
+
+func names(items []testInstance) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func headers(l *List[testInstance]) []string {
+	var headers []string
+	for _, col := range l.columns {
+		headers = append(headers, col.Header)
+	}
+	return headers
+}
+
+func TestListColumns(t *testing.T) {
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{"default", ListOptions{}, []string{"Name", "Status", "Size"}},
+		{"columns", ListOptions{Columns: "id, hardware.cpu_core,.name"}, []string{"ID", "hardware.cpu_core", "Name"}},
+		{"wide", ListOptions{Wide: true}, []string{"Name", "Status", "Size", "ID", "tags"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewList(tt.opts, testColumns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := headers(l); !slices.Equal(got, tt.want) {
+				t.Errorf("got columns %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	tests := []struct {
+		filters []string
+		want    []string
+	}{
+		{[]string{"status=up"}, []string{"web-1", "db"}},
+		{[]string{"status!=UP"}, []string{"web-10", "new"}},
+		{[]string{"name=web-*"}, []string{"web-1", "web-10"}},
+		{[]string{"name=web-?"}, []string{"web-1"}},
+		{[]string{"name!=web*"}, []string{"db", "new"}},
+		{[]string{"hardware.cpu_core>=4"}, []string{"web-10", "db"}},
+		{[]string{"hardware.cpu_core<4"}, []string{"web-1", "new"}},
+		{[]string{"hardware.memory_mb>8192"}, []string{"web-10"}},
+		{[]string{"hardware.memory_mb<=8192", "status=UP"}, []string{"web-1", "db"}},
+		{[]string{"size=xx"}, []string{"web-1"}},
+		{[]string{"tags=a, b"}, []string{"web-1"}},
+		{[]string{"name = db"}, []string{"db"}},
+	}
+	for _, tt := range tests {
+		l, err := NewList(ListOptions{Filter: tt.filters}, testColumns)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.filters, err)
+		}
+		if got := names(l.apply(testInstances)); !slices.Equal(got, tt.want) {
+			t.Errorf("%v: got %v, want %v", tt.filters, got, tt.want)
+		}
+	}
+}
+
+func TestListSort(t *testing.T) {
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{"name", []string{"db", "new", "web-1", "web-10"}},
+		{"-name", []string{"web-10", "web-1", "new", "db"}},
+		// Numbers sort as numbers, 16384 after 8192
+		{"-hardware.memory_mb", []string{"web-10", "db", "web-1", "new"}},
+		// Text sorts by byte, so UP before up
+		{"status", []string{"new", "web-10", "web-1", "db"}},
+	}
+	for _, tt := range tests {
+		l, err := NewList(ListOptions{SortBy: tt.sortBy}, testColumns)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.sortBy, err)
+		}
+		if got := names(l.apply(testInstances)); !slices.Equal(got, tt.want) {
+			t.Errorf("--sort-by %s: got %v, want %v", tt.sortBy, got, tt.want)
+		}
+	}
+}
+
+func TestListFilterAndSortLeaveItems(t *testing.T) {
+	items := slices.Clone(testInstances)
+	l, err := NewList(ListOptions{SortBy: "-name", Filter: []string{"status=UP"}}, testColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.apply(items)
+	if !slices.Equal(names(items), names(testInstances)) {
+		t.Errorf("apply reordered the items: %v", names(items))
+	}
+}
+
+func TestNewListErrors(t *testing.T) {
+	tests := []struct {
+		opts ListOptions
+		want string
+	}{
+		{ListOptions{Columns: "name,bogus"}, `unknown column "bogus"`},
+		{ListOptions{SortBy: "-bogus"}, `unknown column "bogus"`},
+		{ListOptions{Filter: []string{"bogus=1"}}, `unknown column "bogus"`},
+		{ListOptions{Filter: []string{"status"}}, `invalid filter "status"`},
+		{ListOptions{Filter: []string{"=UP"}}, `invalid filter "=UP"`},
+		{ListOptions{Columns: "Internal"}, `unknown column "Internal"`},
+	}
+	for _, tt := range tests {
+		if _, err := NewList(tt.opts, testColumns); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("NewList(%+v) = %v, want an error containing %s", tt.opts, err, tt.want)
+		}
+	}
+}
+
+func TestCell(t *testing.T) {
+	item := testInstances[0]
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"name", "web-1"},
+		{"hardware.cpu_core", "2"},
+		{"tags", "a, b"},
+		{"size", "xx"},
+	}
+	for _, tt := range tests {
+		col, err := column(tt.path, testColumns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cell(col, item); got != tt.want {
+			t.Errorf("cell %s = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	// Nil pointers on the way are empty
+	col, _ := column("hardware.memory_mb", testColumns)
+	if got := cell(col, testInstances[3]); got != "" {
+		t.Errorf("cell of a nil pointer = %q, want empty", got)
+	}
+}
+
+func TestWithItems(t *testing.T) {
+	resp := &testInstanceList{Data: testInstances}
+	got, ok := withItems(resp, testInstances[:1]).(*testInstanceList)
+	if !ok || len(got.Data) != 1 {
+		t.Errorf("got %+v, want the response with one item", got)
+	}
+	if len(resp.Data) != len(testInstances) {
+		t.Error("withItems changed the response")
+	}
+	if got, ok := withItems(testInstances, testInstances[:2]).([]testInstance); !ok || len(got) != 2 {
+		t.Errorf("got %v, want the slice replaced", got)
+	}
+}
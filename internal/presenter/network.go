@@ -1,54 +1,49 @@
 package presenter
 
 import (
-	"fmt"
-	"os"
-
-	"github.com/olekukonko/tablewriter"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func RenderNetworkDetail(network responses.Network) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Field", "Value"})
-	table.Append([]string{"ID", network.ID})
-	table.Append([]string{"Name", network.Name})
-	table.Append([]string{"Status", network.Status})
-	table.Append([]string{"Network Offering ID", network.NetworkOffering.ID})
-	table.Append([]string{"Network Offering Name", network.NetworkOffering.Name})
-	table.Render()
+func RenderNetworkDetail(resp *responses.NetworkShowResponse) error {
+	network := resp.Data
+	table := NewTable("Field", "Value")
+	table.Append("ID", network.ID)
+	table.Append("Name", network.Name)
+	table.Append("Status", network.Status)
+	table.Append("Network Offering ID", network.NetworkOffering.ID)
+	table.Append("Network Offering Name", network.NetworkOffering.Name)
+	return Output(resp, table)
 }
 
-func RenderNetworkList(networks []responses.Network) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Status", "Offering Name"})
-
-	for _, network := range networks {
-		table.Append([]string{network.ID, network.Name, network.Status, network.NetworkOffering.Name})
-	}
-	table.Render()
+// NetworkColumns are the columns of network list.
+var NetworkColumns = []Column[responses.Network]{
+	{Path: "id", Header: "ID"},
+	{Path: "name", Header: "Name"},
+	{Path: "status", Header: "Status"},
+	{Path: "network_offering.name", Header: "Offering Name"},
 }
 
-func RenderInstanceNetworkList(instances []responses.InstanceNetwork) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Instance Network ID", "Instance ID", "IP Address", "Network Name", "Is Default"})
-
-	for _, instance := range instances {
-		isDefault := "No"
+// InstanceNetworkColumns are the columns of network instance list.
+var InstanceNetworkColumns = []Column[responses.InstanceNetwork]{
+	{Path: "id", Header: "Instance Network ID"},
+	{Path: "instance_id", Header: "Instance ID"},
+	{Path: "ipaddress", Header: "IP Address"},
+	{Path: "network.name", Header: "Network Name"},
+	{Path: "is_default", Header: "Is Default", Value: func(instance responses.InstanceNetwork) string {
 		if instance.IsDefault {
-			isDefault = "Yes"
+			return "Yes"
 		}
-		table.Append([]string{instance.ID, instance.InstanceID, instance.IPAddress, instance.Network.Name, isDefault})
-	}
-	table.Render()
+		return "No"
+	}},
 }
 
-func RenderPortForwardList(rules []responses.PortForwardRule) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Protocol", "Public Port", "Private Port", "Private IP", "Status", "Created At"})
-
-	for _, rule := range rules {
-		table.Append([]string{rule.ID, rule.Protocol, fmt.Sprintf("%d", rule.PublicPort), fmt.Sprintf("%d", rule.PrivatePort), rule.PrivateIP, rule.Status, fmt.Sprintf("%d", rule.CreatedAt)})
-	}
-	table.Render()
+// PortForwardColumns are the columns of network port-forward list.
+var PortForwardColumns = []Column[responses.PortForwardRule]{
+	{Path: "id", Header: "ID"},
+	{Path: "protocol", Header: "Protocol"},
+	{Path: "public_port", Header: "Public Port"},
+	{Path: "private_port", Header: "Private Port"},
+	{Path: "private_ip", Header: "Private IP"},
+	{Path: "status", Header: "Status"},
+	{Path: "created_at", Header: "Created At"},
 }
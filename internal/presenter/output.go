@@ -0,0 +1,191 @@
+package presenter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// Output formats accepted by --output and the output config key.
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatCSV   = "csv"
+	FormatTSV   = "tsv"
+)
+
+// Format returns the output format selected with --output or the output config
+// key. It defaults to table.
+func Format() string {
+	if format := viper.GetString("output"); format != "" {
+		return format
+	}
+	return FormatTable
+}
+
+// Tabular reports whether the output is an ASCII table meant for people.
+// Progress and status messages go to stderr in the other formats, and with
+// --query, so stdout can be parsed.
+func Tabular() bool {
+	return Format() == FormatTable && Query() == ""
+}
+
+// Status prints a message for people: on stdout in table format, otherwise on
+// stderr.
+func Status(format string, args ...any) {
+	w := os.Stdout
+	if !Tabular() {
+		w = os.Stderr
+	}
+	fmt.Fprintf(w, format+"\n", args...)
+}
+
+// Empty prints message and reports true when a table has no rows to show. The
+// machine-readable formats still render, e.g. as [] or a header line.
+func Empty(rows int, message string) bool {
+	if rows > 0 || !Tabular() {
+		return false
+	}
+	Status(message)
+	return true
+}
+
+// Result reports the outcome of a command that changes something. JSON and
+// YAML encode v, the API response, and --query is evaluated against it; the
+// other formats print the message as Status does. So does every format when v
+// is nil because the API returned no body.
+func Result(v any, format string, args ...any) error {
+	if expr := Query(); expr != "" && v != nil {
+		return outputQuery(expr, v)
+	}
+	switch Format() {
+	case FormatJSON, FormatYAML:
+		if v != nil {
+			return write(os.Stdout, Format(), v, nil)
+		}
+	}
+	Status(format, args...)
+	return nil
+}
+
+// Table is the column model shared by the table, CSV and TSV formats.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// NewTable returns an empty table with the given column headers.
+func NewTable(headers ...string) *Table {
+	return &Table{Headers: headers}
+}
+
+// Append adds a row. It must have one value per header.
+func (t *Table) Append(row ...string) {
+	t.Rows = append(t.Rows, row)
+}
+
+// Output writes a command's result to stdout in the selected format. JSON and
+// YAML encode v, the API response as returned by pkg/http; the other formats
+// render t. With --query, the result of the query against v is written
+// instead.
+func Output(v any, t *Table) error {
+	if expr := Query(); expr != "" {
+		return outputQuery(expr, v)
+	}
+	return write(os.Stdout, Format(), v, t)
+}
+
+func outputQuery(expr string, v any) error {
+	result, err := evalQuery(expr, v)
+	if err != nil {
+		return err
+	}
+	return writeQueryResult(os.Stdout, Format(), result)
+}
+
+func write(w io.Writer, format string, v any, t *Table) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, v)
+	case FormatYAML:
+		return writeYAML(w, v)
+	case FormatCSV:
+		return writeDelimited(w, ',', t)
+	case FormatTSV:
+		return writeDelimited(w, '\t', t)
+	case FormatTable:
+		writeTable(w, t)
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q; use table, json, yaml, csv or tsv", format)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON output: %w", err)
+	}
+	return nil
+}
+
+// writeYAML encodes v through its JSON form, so field names follow the json
+// tags of the response structs and keep their order.
+func writeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML output: %w", err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("failed to encode YAML output: %w", err)
+	}
+	blockStyle(&node)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return fmt.Errorf("failed to encode YAML output: %w", err)
+	}
+	return enc.Close()
+}
+
+// blockStyle turns the flow style of parsed JSON into YAML block style.
+func blockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		node.Style &^= yaml.DoubleQuotedStyle
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func writeDelimited(w io.Writer, comma rune, t *Table) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if len(t.Headers) > 0 {
+		if err := cw.Write(t.Headers); err != nil {
+			return err
+		}
+	}
+	if err := cw.WriteAll(t.Rows); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
+
+func writeTable(w io.Writer, t *Table) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(t.Headers)
+	table.SetAutoWrapText(false)
+	table.AppendBulk(t.Rows)
+	table.Render()
+}
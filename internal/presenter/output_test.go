@@ -0,0 +1,104 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenRecord has the values that need escaping or quoting in some format.
+type goldenRecord struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Note    string   `json:"note"`
+	Count   int      `json:"count"`
+	Price   float64  `json:"price"`
+	Enabled bool     `json:"enabled"`
+	Zone    *string  `json:"zone"`
+	Tags    []string `json:"tags"`
+}
+
+var goldenRecords = []goldenRecord{
+	{ID: "1", Name: "web, primary", Note: "first line\nsecond line", Count: 2, Price: 1.5, Enabled: true, Tags: []string{"a", "b"}},
+	{ID: "2", Name: "tab\tseparated", Note: `say "hi"`, Tags: []string{}},
+	{ID: "0123", Name: "true", Note: "key: value # not a comment"},
+	{ID: "4", Name: " padded ", Note: "", Count: -1},
+}
+
+func goldenTable() *Table {
+	t := NewTable("ID", "Name", "Note")
+	for _, r := range goldenRecords {
+		t.Append(r.ID, r.Name, r.Note)
+	}
+	return t
+}
+
+// checkGolden compares got with testdata/name, or rewrites the file with -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %s output:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestWriteGolden(t *testing.T) {
+	v := struct {
+		Data []goldenRecord `json:"data"`
+	}{goldenRecords}
+	for _, format := range []string{FormatJSON, FormatYAML, FormatCSV, FormatTSV} {
+		t.Run(format, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := write(&b, format, v, goldenTable()); err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, "records."+format, b.Bytes())
+		})
+	}
+}
+
+// TestWriteDelimitedRoundTrip reads the CSV and TSV output back, so every value
+// with a comma, tab, quote or newline must have been escaped.
+func TestWriteDelimitedRoundTrip(t *testing.T) {
+	table := goldenTable()
+	want := append([][]string{table.Headers}, table.Rows...)
+	for _, comma := range []rune{',', '\t'} {
+		var b bytes.Buffer
+		if err := writeDelimited(&b, comma, table); err != nil {
+			t.Fatal(err)
+		}
+		r := csv.NewReader(&b)
+		r.Comma = comma
+		got, err := r.ReadAll()
+		if err != nil {
+			t.Fatalf("%q: %v", comma, err)
+		}
+		if !slices.EqualFunc(got, want, slices.Equal) {
+			t.Errorf("%q: read back %q, want %q", comma, got, want)
+		}
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	err := write(&bytes.Buffer{}, "xml", nil, goldenTable())
+	if err == nil || !strings.Contains(err.Error(), `unknown output format "xml"`) {
+		t.Errorf("got error %v, want an unknown format", err)
+	}
+}
@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// AllPagesMeta describes n items collected from every page with --all, so the
+// machine-readable formats report them as a single page.
+func AllPagesMeta(n int) responses.Meta {
+	meta := responses.Meta{CurrentPage: 1, LastPage: 1, PerPage: n, Total: n}
+	if n > 0 {
+		meta.From, meta.To = 1, n
+	}
+	return meta
+}
+
+// RenderPageInfo tells the user on stderr that more pages are available.
+func RenderPageInfo(meta responses.Meta) {
+	if meta.LastPage <= meta.CurrentPage {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Page %d of %d (%d total). Use --page %d for the next page or --all to fetch every page.\n",
+		meta.CurrentPage, meta.LastPage, meta.Total, meta.CurrentPage+1)
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"github.com/virak-cloud/cli/internal/cli"
+)
+
+// ProfileColumns are the columns of profile list.
+var ProfileColumns = []Column[cli.Profile]{
+	{Path: "active", Header: "Active", Value: func(p cli.Profile) string { return activeMarker(p.Active) }},
+	{Path: "name", Header: "Name"},
+	{Path: "logged_in", Header: "Logged In", Value: func(p cli.Profile) string { return yesNo(p.LoggedIn) }},
+	{Path: "zone", Header: "Default Zone", Value: formatZone},
+}
+
+func RenderProfile(p cli.Profile) error {
+	table := NewTable("Field", "Value")
+	table.Append("Name", p.Name)
+	table.Append("Active", yesNo(p.Active))
+	table.Append("Logged In", yesNo(p.LoggedIn))
+	table.Append("Default Zone ID", p.ZoneID)
+	table.Append("Default Zone Name", p.ZoneName)
+	return Output(p, table)
+}
+
+func activeMarker(active bool) string {
+	if active {
+		return "*"
+	}
+	return ""
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func formatZone(p cli.Profile) string {
+	if p.ZoneName != "" {
+		return p.ZoneName + " (" + p.ZoneID + ")"
+	}
+	return p.ZoneID
+}
+
+// maskToken shows only the last four characters of a token.
+func maskToken(token string) string {
+	switch {
+	case token == "":
+		return "(not logged in)"
+	case len(token) <= 8:
+		return "****"
+	default:
+		return "****" + token[len(token)-4:]
+	}
+}
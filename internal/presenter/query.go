@@ -0,0 +1,220 @@
+package presenter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/spf13/viper"
+)
+
+// Query returns the expression given with --query, or "" when there is none.
+func Query() string {
+	return viper.GetString("query")
+}
+
+// CompileQuery parses a --query expression. Expressions are JMESPath, e.g.
+// data[?status=='UP'].id. The leading "." of a jq path and the "$" of a
+// JSONPath are accepted, so .data[].id and $.data[*].id work too, and so are
+// unquoted literals such as `UP`, which older JMESPath versions allowed.
+func CompileQuery(expr string) (*jmespath.JMESPath, error) {
+	expr = strings.TrimSpace(expr)
+	switch {
+	case expr == "$" || expr == ".":
+		expr = "@"
+	case strings.HasPrefix(expr, "$."):
+		expr = expr[2:]
+	case strings.HasPrefix(expr, "$["):
+		expr = expr[1:]
+	case strings.HasPrefix(expr, ".") && !strings.HasPrefix(expr, ".."):
+		expr = expr[1:]
+	}
+	q, err := jmespath.Compile(quoteBareLiterals(expr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --query expression: %w", err)
+	}
+	return q, nil
+}
+
+// quoteBareLiterals turns `...` literals that are not valid JSON into JSON
+// strings, so `UP` means `"UP"`. Quoted strings are left alone.
+func quoteBareLiterals(expr string) string {
+	var b strings.Builder
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if c != '\'' && c != '"' && c != '`' {
+			b.WriteByte(c)
+			continue
+		}
+		end := closing(expr, i+1, c)
+		if end < 0 {
+			// Unterminated, leave it to the parser to report
+			b.WriteString(expr[i:])
+			break
+		}
+		literal := strings.TrimSpace(strings.ReplaceAll(expr[i+1:end-1], "\\`", "`"))
+		if c == '`' && !json.Valid([]byte(literal)) {
+			quoted, _ := json.Marshal(literal)
+			b.WriteString("`" + strings.ReplaceAll(string(quoted), "`", "\\`") + "`")
+		} else {
+			b.WriteString(expr[i:end])
+		}
+		i = end - 1
+	}
+	return b.String()
+}
+
+// closing returns the index just past the quote that closes the one before
+// start, skipping escaped quotes, or -1 when it is not closed.
+func closing(expr string, start int, quote byte) int {
+	for i := start; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// evalQuery evaluates expr against the JSON form of v, so field names are the
+// json tags of the response structs.
+func evalQuery(expr string, v any) (any, error) {
+	q, err := CompileQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate --query: %w", err)
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to evaluate --query: %w", err)
+	}
+	result, err := q.Search(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate --query: %w", err)
+	}
+	return result, nil
+}
+
+// writeQueryResult renders the result of a query. JSON and YAML encode it. The
+// other formats print scalars and lists of scalars one per line, for shell
+// loops, and lay out objects and lists of objects as tables.
+func writeQueryResult(w io.Writer, format string, result any) error {
+	switch format {
+	case FormatJSON, FormatYAML:
+		return write(w, format, result, nil)
+	}
+	if lines, ok := scalarLines(result); ok {
+		for _, line := range lines {
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return write(w, format, result, queryTable(result))
+}
+
+// scalarLines returns the text of a scalar or of every element of a list of
+// scalars. Nulls, e.g. of a field that does not exist, print nothing.
+func scalarLines(v any) ([]string, bool) {
+	items, isList := v.([]any)
+	if !isList {
+		items = []any{v}
+	}
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		switch item.(type) {
+		case map[string]any, []any:
+			return nil, false
+		case nil:
+			continue
+		}
+		lines = append(lines, scalarText(item))
+	}
+	return lines, true
+}
+
+// queryTable lays out an object as Field/Value rows and a list of objects with
+// one column per key. Nested values are shown as JSON.
+func queryTable(v any) *Table {
+	if obj, ok := v.(map[string]any); ok {
+		t := NewTable("Field", "Value")
+		for _, key := range sortedKeys(obj) {
+			t.Append(key, cellText(obj[key]))
+		}
+		return t
+	}
+
+	items, _ := v.([]any)
+	seen := map[string]bool{}
+	var keys []string
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, key := range sortedKeys(obj) {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		t := NewTable("Value")
+		for _, item := range items {
+			t.Append(cellText(item))
+		}
+		return t
+	}
+	t := NewTable(keys...)
+	for _, item := range items {
+		obj, _ := item.(map[string]any)
+		row := make([]string, len(keys))
+		for i, key := range keys {
+			row[i] = cellText(obj[key])
+		}
+		t.Append(row...)
+	}
+	return t
+}
+
+func sortedKeys(obj map[string]any) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func cellText(v any) string {
+	switch v.(type) {
+	case map[string]any, []any:
+		data, _ := json.Marshal(v)
+		return string(data)
+	case nil:
+		return ""
+	}
+	return scalarText(v)
+}
+
+func scalarText(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,111 @@
+package presenter
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQuoteBareLiterals(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"bare word", "data[?status==`UP`]", "data[?status==`\"UP\"`]"},
+		{"padded bare word", "data[?status==` UP `]", "data[?status==`\"UP\"`]"},
+		{"raw string", "data[?status=='UP']", "data[?status=='UP']"},
+		{"raw string with backtick", "data[?name=='a`b']", "data[?name=='a`b']"},
+		{"raw string with escaped quote", `data[?name=='it\'s']`, `data[?name=='it\'s']`},
+		{"quoted identifier", `data[?"status"=='UP']`, `data[?"status"=='UP']`},
+		{"JSON string", "data[?status==`\"UP\"`]", "data[?status==`\"UP\"`]"},
+		{"number", "data[?cpu==`2`]", "data[?cpu==`2`]"},
+		{"float", "data[?price>`1.5`]", "data[?price>`1.5`]"},
+		{"boolean", "data[?ready==`true`]", "data[?ready==`true`]"},
+		{"null", "data[?zone==`null`]", "data[?zone==`null`]"},
+		{"object", "data[?tags==`{\"a\": 1}`]", "data[?tags==`{\"a\": 1}`]"},
+		{"escaped backtick", "data[?name==`a\\`b`]", "data[?name==`\"a\\`b\"`]"},
+		{"two literals", "data[?status==`UP` || status==`DOWN`]", "data[?status==`\"UP\"` || status==`\"DOWN\"`]"},
+		{"unterminated", "data[?status==`UP", "data[?status==`UP"},
+		{"no literals", "data[].id", "data[].id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quoteBareLiterals(tt.expr); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+const queryDoc = `{"data": [
+	{"id": "1", "name": "web", "status": "UP", "cpu": 2, "ready": true, "zone": null, "disks": [{"size": 20}, {"size": 100}]},
+	{"id": "2", "name": "db", "status": "DOWN", "cpu": 8, "ready": false, "zone": "z1", "disks": [{"size": 50}]},
+	{"id": "3", "name": "it's", "status": "up", "cpu": 4, "ready": true, "zone": "z1", "disks": []}
+]}`
+
+func TestCompileQuery(t *testing.T) {
+	var doc any
+	if err := json.Unmarshal([]byte(queryDoc), &doc); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"data[?status=='UP'].id", `["1"]`},
+		{"data[?status==`UP`].id", `["1"]`},
+		{"data[?status==`\"UP\"`].id", `["1"]`},
+		{`data[?name=='it\'s'].id`, `["3"]`},
+		{"data[?cpu > `2`].id", `["2","3"]`},
+		{"data[?cpu == `4`].name", `["it's"]`},
+		{"data[?ready == `false`].id", `["2"]`},
+		{"data[?zone == `null`].id", `["1"]`},
+		{"data[?zone != `null` && ready].id", `["3"]`},
+		{"data[?disks[?size > `60`]].id", `["1"]`},
+		{"data[?length(disks[?size >= `20`]) == `1`].id", `["2"]`},
+		{"length(data)", `3`},
+		{".data[].id", `["1","2","3"]`},
+		{"$.data[*].id", `["1","2","3"]`},
+		{"$.data[0].id", `"1"`},
+		{".", queryDoc},
+		{" $ ", queryDoc},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			q, err := CompileQuery(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := q.Search(doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want any
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCompileQueryInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"data[?status=='UP'",
+		"data[?status==`UP",
+		"data[?status=='UP]",
+		"data[?status=UP]",
+		"..data",
+		"data[].[",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := CompileQuery(expr); err == nil || !strings.HasPrefix(err.Error(), "invalid --query expression") {
+				t.Errorf("got error %v, want an invalid --query expression", err)
+			}
+		})
+	}
+}
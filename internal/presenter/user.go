@@ -2,68 +2,64 @@ package presenter
 
 import (
 	"fmt"
-	"os"
 
-	"github.com/olekukonko/tablewriter"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func RenderTokenAbilities(abilities []string) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Ability"})
-	for _, ability := range abilities {
-		table.Append([]string{ability})
+func RenderTokenAbilities(resp *responses.UserTokenAbilitiesResponse) error {
+	table := NewTable("Ability")
+	for _, ability := range resp.Abilities {
+		table.Append(ability)
 	}
-	table.Render()
+	return Output(resp, table)
 }
 
-func RenderSSHKeyList(sshKeys []responses.UserSSHKey) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Key", "Created At"})
-	for _, key := range sshKeys {
-		keyData := key.DataValue
-		if len(keyData) > 10 {
-			keyData = keyData[:17] + "..."
+// SSHKeyColumns are the columns of user ssh-key list.
+var SSHKeyColumns = []Column[responses.UserSSHKey]{
+	{Path: "id", Header: "ID"},
+	{Path: "display_name", Header: "Name"},
+	{Path: "datavalue", Header: "Key", Value: func(key responses.UserSSHKey) string {
+		// Tables shorten the key, the machine-readable formats keep it whole
+		if Tabular() && len(key.DataValue) > 20 {
+			return key.DataValue[:17] + "..."
 		}
-		table.Append([]string{key.ID, key.DisplayName, keyData, key.CreatedAt})
-	}
-	table.Render()
+		return key.DataValue
+	}},
+	{Path: "created_at", Header: "Created At"},
 }
 
-func RenderUserProfile(profile *responses.UserProfileResponse) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Field", "Value"})
-	table.SetAutoWrapText(false)
+func RenderUserProfile(profile *responses.UserProfileResponse) error {
+	table := NewTable("Field", "Value")
 
 	data := profile.Data
 
 	// Add rows for each field
-	table.Append([]string{"ID", data.ID})
-	table.Append([]string{"Name", data.Name})
-	table.Append([]string{"Language", data.Language})
-	table.Append([]string{"National Code", data.NationalCode})
-	table.Append([]string{"Email", data.Email})
-	table.Append([]string{"Phone", data.Phone})
-	table.Append([]string{"Country", formatInterface(data.Country)})
-	table.Append([]string{"State", formatInterface(data.State)})
-	table.Append([]string{"City", formatInterface(data.City)})
-	table.Append([]string{"Address", formatInterface(data.Address)})
-	table.Append([]string{"ZIP", formatInterface(data.Zip)})
-	table.Append([]string{"Website", formatInterface(data.Website)})
-	table.Append([]string{"Referral Code", formatInterface(data.Extra.ReferralCode)})
-	table.Append([]string{"Status", data.Status})
-	table.Append([]string{"Type", data.Type})
-	table.Append([]string{"Created At", data.CreatedAt})
-	table.Append([]string{"Updated At", data.UpdatedAt})
-	table.Append([]string{"Customer Zones Count", formatInterface(data.CustomerZonesCount)})
-	table.Append([]string{"Instances Count", formatInterface(data.InstancesCount)})
-	table.Append([]string{"Payments Count", formatInterface(data.PaymentsCount)})
-	table.Append([]string{"Wallets Count", formatInterface(data.WalletsCount)})
-	table.Append([]string{"Invite Code", data.InviteCode})
-	table.Append([]string{"Invited By Me", fmt.Sprintf("%d", data.InvitedByMe)})
-	table.Append([]string{"Picture", data.Picture})
+	table.Append("ID", data.ID)
+	table.Append("Name", data.Name)
+	table.Append("Language", data.Language)
+	table.Append("National Code", data.NationalCode)
+	table.Append("Email", data.Email)
+	table.Append("Phone", data.Phone)
+	table.Append("Country", formatInterface(data.Country))
+	table.Append("State", formatInterface(data.State))
+	table.Append("City", formatInterface(data.City))
+	table.Append("Address", formatInterface(data.Address))
+	table.Append("ZIP", formatInterface(data.Zip))
+	table.Append("Website", formatInterface(data.Website))
+	table.Append("Referral Code", formatInterface(data.Extra.ReferralCode))
+	table.Append("Status", data.Status)
+	table.Append("Type", data.Type)
+	table.Append("Created At", data.CreatedAt)
+	table.Append("Updated At", data.UpdatedAt)
+	table.Append("Customer Zones Count", formatInterface(data.CustomerZonesCount))
+	table.Append("Instances Count", formatInterface(data.InstancesCount))
+	table.Append("Payments Count", formatInterface(data.PaymentsCount))
+	table.Append("Wallets Count", formatInterface(data.WalletsCount))
+	table.Append("Invite Code", data.InviteCode)
+	table.Append("Invited By Me", fmt.Sprintf("%d", data.InvitedByMe))
+	table.Append("Picture", data.Picture)
 
-	table.Render()
+	return Output(profile, table)
 }
 
 // Helper function to format interface{} values (handles null values)
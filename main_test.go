@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+// TestMain runs the CLI instead of the tests when the test binary is started
+// by runCLI, so commands are tested end to end, exit codes included.
+func TestMain(m *testing.M) {
+	if os.Getenv("VIRAK_CLI_TEST_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// cliEnv is a throwaway home directory and a fake API for the CLI to use.
+type cliEnv struct {
+	t    *testing.T
+	home string
+	api  string
+	// token is passed as VIRAK_TOKEN unless empty.
+	token string
+}
+
+func newCLIEnv(t *testing.T, opts ...fake.Option) *cliEnv {
+	t.Helper()
+	srv := httptest.NewServer(fake.NewServer(opts...))
+	t.Cleanup(srv.Close)
+	return &cliEnv{t: t, home: t.TempDir(), api: srv.URL, token: "test-token"}
+}
+
+type cliResult struct {
+	stdout string
+	stderr string
+	code   int
+}
+
+// run runs the CLI with args and stdin.
+func (e *cliEnv) run(stdin string, args ...string) cliResult {
+	e.t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(),
+		"VIRAK_CLI_TEST_MAIN=1",
+		"HOME="+e.home,
+		"USERPROFILE="+e.home,
+		"VIRAK_API_URL="+e.api,
+		"VIRAK_ZONE_ID="+fake.DefaultZoneID,
+		"VIRAK_TOKEN="+e.token,
+	)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		e.t.Fatalf("failed to run %v: %v", args, err)
+	}
+	return cliResult{stdout: stdout.String(), stderr: stderr.String(), code: cmd.ProcessState.ExitCode()}
+}
+
+// ok runs the CLI and fails the test unless it succeeds.
+func (e *cliEnv) ok(args ...string) string {
+	e.t.Helper()
+	res := e.run("", args...)
+	if res.code != 0 {
+		e.t.Fatalf("%v exited with %d:\n%s", args, res.code, res.stderr)
+	}
+	return res.stdout
+}
+
+func TestZoneList(t *testing.T) {
+	env := newCLIEnv(t)
+	var out struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(env.ok("zone", "list", "-o", "json")), &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Data) != 2 || out.Data[0].ID != fake.DefaultZoneID || out.Data[0].Name != "Tehran-1" {
+		t.Errorf("got zones %+v, want the two seeded ones", out.Data)
+	}
+
+	table := env.ok("zone", "list")
+	if !strings.Contains(table, "Tehran-1") || !strings.Contains(table, "Tehran-2") {
+		t.Errorf("got table:\n%s\nwant both zones", table)
+	}
+}
+
+func TestLoginWhoamiLogout(t *testing.T) {
+	env := newCLIEnv(t)
+	env.token = ""
+
+	if res := env.run("", "auth", "whoami"); res.code != 3 {
+		t.Fatalf("whoami before login exited with %d, want 3:\n%s", res.code, res.stderr)
+	}
+	if res := env.run("my-token\n", "login", "--token-stdin"); res.code != 0 {
+		t.Fatalf("login exited with %d:\n%s", res.code, res.stderr)
+	}
+	credentials, err := os.ReadFile(env.home + "/.virak-cli/credentials.json")
+	if err != nil || !strings.Contains(string(credentials), "my-token") {
+		t.Fatalf("got credentials file %q, %v, want the token saved", credentials, err)
+	}
+
+	whoami := env.ok("auth", "whoami")
+	if !strings.Contains(whoami, "Demo User") || !strings.Contains(whoami, "default") {
+		t.Errorf("got whoami:\n%s\nwant the user and profile", whoami)
+	}
+
+	env.ok("logout")
+	if res := env.run("", "auth", "whoami"); res.code != 3 {
+		t.Errorf("whoami after logout exited with %d, want 3:\n%s", res.code, res.stderr)
+	}
+}
+
+func TestAuthStillLogsIn(t *testing.T) {
+	env := newCLIEnv(t)
+	env.token = ""
+
+	res := env.run("my-token\n", "auth", "--token-stdin")
+	if res.code != 0 || !strings.Contains(res.stderr, "use 'virak-cli login'") {
+		t.Fatalf("auth exited with %d:\n%s\nwant a login with a deprecation notice", res.code, res.stderr)
+	}
+	env.ok("auth", "whoami")
+
+	if help := env.ok("auth", "--help"); strings.Contains(help, "token-stdin") {
+		t.Errorf("got help:\n%s\nwant the login flags hidden", help)
+	}
+	if res := env.run("", "auth", "whoam"); res.code != 2 {
+		t.Errorf("auth whoam exited with %d, want 2:\n%s", res.code, res.stderr)
+	}
+}
+
+func TestNetworkLifecycle(t *testing.T) {
+	env := newCLIEnv(t)
+
+	env.ok("network", "create", "l2", "--name", "backend", "--network-offering-id", "01J9Y6ZQ5HNETW0RK000000002", "--wait")
+	id := strings.Trim(strings.TrimSpace(env.ok("network", "list", "-o", "json", "--query", "data[?name=='backend'].id | [0]")), `"`)
+	if len(id) != 26 {
+		t.Fatalf("got network ID %q, want a ULID", id)
+	}
+
+	// Names are resolved like IDs
+	show := env.ok("network", "show", "--networkId", "backend", "--zoneId", "tehran-1", "-o", "json")
+	if !strings.Contains(show, id) {
+		t.Errorf("got network:\n%s\nwant the one with ID %s", show, id)
+	}
+
+	// Without a terminal deleting needs --yes
+	if res := env.run("", "network", "delete", "--networkId", id); res.code != 2 {
+		t.Errorf("delete without --yes exited with %d, want 2:\n%s", res.code, res.stderr)
+	}
+	env.ok("network", "delete", "--networkId", id, "--yes", "--wait")
+	if list := env.ok("network", "list", "-o", "json"); strings.Contains(list, id) {
+		t.Errorf("network %s still listed after delete:\n%s", id, list)
+	}
+}
+
+func TestBucketCreateDryRun(t *testing.T) {
+	env := newCLIEnv(t)
+
+	res := env.run("", "bucket", "create", "--name", "logs", "--policy", "Private", "--dry-run")
+	if res.code != 0 {
+		t.Fatalf("dry run exited with %d:\n%s", res.code, res.stderr)
+	}
+	if out := res.stdout + res.stderr; !strings.Contains(out, "POST") || !strings.Contains(out, `"name":"logs"`) {
+		t.Errorf("got output:\n%s\nwant the request that would be sent", out)
+	}
+	if list := env.ok("bucket", "list", "-o", "json"); strings.Contains(list, `"logs"`) {
+		t.Errorf("the dry run created the bucket:\n%s", list)
+	}
+}
+
+func TestForbiddenByAbilities(t *testing.T) {
+	env := newCLIEnv(t, fake.WithAbilities([]string{"zone:read"}))
+
+	env.ok("zone", "list")
+	res := env.run("", "instance", "list")
+	if res.code != 3 || !strings.Contains(res.stderr, "instance:read") {
+		t.Errorf("instance list exited with %d:\n%s\nwant 3 naming the missing ability", res.code, res.stderr)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	env := newCLIEnv(t)
+	const unknownID = "01J9Y6ZQ5H00000000000000ZZ"
+	// The second network with the name is rejected by the API
+	env.ok("network", "create", "l2", "--name", "db", "--network-offering-id", "01J9Y6ZQ5HNETW0RK000000002")
+	tests := []struct {
+		args []string
+		code int
+	}{
+		{[]string{"zone", "lst"}, 2},
+		{[]string{"network", "create", "l2", "--name", "db", "--network-offering-id", "01J9Y6ZQ5HNETW0RK000000002"}, 5},
+		{[]string{"zone", "list", "--bogus"}, 2},
+		{[]string{"zone", "list", "--filter", "bogus=1"}, 2},
+		{[]string{"zone", "list", "--query", "data[?name=='Tehran-1'"}, 2},
+		{[]string{"config", "set", "retries", "abc"}, 2},
+		{[]string{"network", "create", "l2", "--network-offering-id", "01J9Y6ZQ5HNETW0RK000000002"}, 2},
+		{[]string{"network", "show", "--networkId", "missing"}, 4},
+		{[]string{"network", "show", "--networkId", unknownID}, 4},
+		{[]string{"network", "create", "l2", "--name", "web", "--network-offering-id", unknownID}, 2},
+	}
+	for _, tt := range tests {
+		if res := env.run("", tt.args...); res.code != tt.code {
+			t.Errorf("%v exited with %d, want %d:\n%s", tt.args, res.code, tt.code, res.stderr)
+		}
+	}
+
+	if res := env.run("", "zone"); res.code != 0 || !strings.Contains(res.stdout, "Available Commands") {
+		t.Errorf("zone exited with %d:\n%s\nwant its help", res.code, res.stdout+res.stderr)
+	}
+
+	res := env.run("", "network", "show", "--networkId", unknownID, "-o", "json")
+	var out struct {
+		Error struct {
+			Kind       string `json:"kind"`
+			ExitCode   int    `json:"exit_code"`
+			StatusCode int    `json:"status_code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(res.stderr), &out); err != nil {
+		t.Fatalf("got stderr %q: %v, want the error as JSON", res.stderr, err)
+	}
+	if out.Error.Kind != "not_found" || out.Error.ExitCode != 4 || out.Error.StatusCode != 404 {
+		t.Errorf("got error %+v, want a not_found error with status 404", out.Error)
+	}
+}
+
+func TestLogoutRevokesOnlyWhenAsked(t *testing.T) {
+	env := newCLIEnv(t)
+	env.token = ""
+	login := func() {
+		t.Helper()
+		if res := env.run("my-token\n", "login", "--token-stdin"); res.code != 0 {
+			t.Fatalf("login exited with %d:\n%s", res.code, res.stderr)
+		}
+	}
+
+	login()
+	env.ok("logout")
+	env.token = "my-token"
+	env.ok("auth", "whoami")
+
+	env.token = ""
+	login()
+	env.ok("logout", "--revoke")
+	env.token = "my-token"
+	if res := env.run("", "auth", "whoami"); res.code != 3 {
+		t.Errorf("whoami with the revoked token exited with %d, want 3:\n%s", res.code, res.stderr)
+	}
+}
+
+func TestLogoutDryRun(t *testing.T) {
+	env := newCLIEnv(t)
+	env.token = ""
+	for _, profile := range []string{"default", "staging"} {
+		if res := env.run("my-token\n", "login", "--token-stdin", "--profile", profile); res.code != 0 {
+			t.Fatalf("login exited with %d:\n%s", res.code, res.stderr)
+		}
+	}
+
+	// Without a terminal logging out of every profile needs --yes
+	if res := env.run("", "logout", "--all-profiles"); res.code != 2 {
+		t.Errorf("logout --all-profiles exited with %d, want 2:\n%s", res.code, res.stderr)
+	}
+	out := env.ok("logout", "--all-profiles", "--revoke", "--dry-run")
+	for _, want := range []string{"DELETE " + env.api + "/user/token", `Would remove the token, logs and cached data of profile "staging"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got output:\n%s\nwant %s", out, want)
+		}
+	}
+
+	// Nothing was revoked or removed
+	env.token = "my-token"
+	env.ok("auth", "whoami")
+	env.token = ""
+	for _, profile := range []string{"default", "staging"} {
+		env.ok("auth", "whoami", "--profile", profile)
+	}
+}
@@ -0,0 +1,294 @@
+package http
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// cassetteVersion is written to every cassette so the format can evolve.
+// Version 1 cassettes, which had no body encodings, are still replayed.
+const cassetteVersion = 2
+
+// Encodings of bodies that are not JSON, see Interaction.
+const (
+	bodyEncodingText   = "text"
+	bodyEncodingBase64 = "base64"
+)
+
+// ErrNotRecorded is returned by a Replayer for requests its cassette has no
+// unused interaction for. It is never retried.
+var ErrNotRecorded = errors.New("no recorded interaction")
+
+// Cassette is a recorded sequence of API interactions. Cassettes are stored as
+// indented JSON so they can be attached to bug reports and reviewed by hand.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one request sent to the API and the response it received.
+// Credentials are redacted the same way as in trace logs. URL is the path and
+// query only, so a cassette can be replayed against any base URL.
+//
+// JSON bodies are stored as they are, so they stay readable. Other bodies are
+// stored as a JSON string and their encoding says how: "text" for UTF-8 text
+// and "base64" for anything else.
+type Interaction struct {
+	Method              string          `json:"method"`
+	URL                 string          `json:"url"`
+	RequestHeaders      http.Header     `json:"requestHeaders,omitempty"`
+	RequestBody         json.RawMessage `json:"requestBody,omitempty"`
+	RequestBodyEncoding string          `json:"requestBodyEncoding,omitempty"`
+	Status              int             `json:"status,omitempty"`
+	Headers             http.Header     `json:"headers,omitempty"`
+	Body                json.RawMessage `json:"body,omitempty"`
+	BodyEncoding        string          `json:"bodyEncoding,omitempty"`
+	// Error holds the transport error of requests that got no response.
+	Error string `json:"error,omitempty"`
+}
+
+// LoadCassette reads a cassette written by a Recorder.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+	switch cassette.Version {
+	case cassetteVersion:
+	case 1:
+		// Version 1 stored every body that is not JSON as a JSON string
+		for i := range cassette.Interactions {
+			in := &cassette.Interactions[i]
+			if len(in.Body) > 0 && in.Body[0] == '"' {
+				in.BodyEncoding = bodyEncodingText
+			}
+			if len(in.RequestBody) > 0 && in.RequestBody[0] == '"' {
+				in.RequestBodyEncoding = bodyEncodingText
+			}
+		}
+		cassette.Version = cassetteVersion
+	default:
+		return nil, fmt.Errorf("unsupported cassette version %d in %s", cassette.Version, path)
+	}
+	for _, in := range cassette.Interactions {
+		for _, encoding := range []string{in.RequestBodyEncoding, in.BodyEncoding} {
+			if encoding != "" && encoding != bodyEncodingText && encoding != bodyEncodingBase64 {
+				return nil, fmt.Errorf("unsupported body encoding %q of %s %s in %s", encoding, in.Method, in.URL, path)
+			}
+		}
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to path. The file is only readable by the owner
+// since response bodies may contain account data.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Recorder captures every request sent by the clients it is attached to and
+// rewrites its cassette after each one, so nothing is lost when the CLI exits
+// early. A Recorder may be shared by several clients.
+type Recorder struct {
+	path string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates an empty cassette at path, truncating an existing file.
+func NewRecorder(path string) (*Recorder, error) {
+	r := &Recorder{path: path, cassette: Cassette{Version: cassetteVersion, Interactions: []Interaction{}}}
+	if err := r.cassette.Save(path); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// WithRecorder records the client's requests into rec.
+func WithRecorder(rec *Recorder) Option {
+	return func(c *Client) {
+		c.HttpClient.Transport = &recordingTransport{base: c.HttpClient.Transport, recorder: rec}
+	}
+}
+
+type recordingTransport struct {
+	base     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	in := Interaction{
+		Method:         req.Method,
+		URL:            req.URL.RequestURI(),
+		RequestHeaders: RedactHeaders(req.Header),
+	}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(body)
+			body.Close()
+			in.RequestBody, in.RequestBodyEncoding = encodeCassetteBody(RedactBody(payload))
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		in.Error = err.Error()
+		return nil, errors.Join(err, t.recorder.add(in))
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	in.Status = resp.StatusCode
+	in.Headers = RedactHeaders(resp.Header)
+	in.Body, in.BodyEncoding = encodeCassetteBody(RedactBody(payload))
+	if err := t.recorder.add(in); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *Recorder) add(in Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	return r.cassette.Save(r.path)
+}
+
+// Replayer answers requests from a cassette instead of the network. Each
+// request is served by the first unused interaction with the same method and
+// URL, so repeated calls (e.g. polling with --wait) replay in recorded order.
+type Replayer struct {
+	path string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer loads the cassette at path.
+func NewReplayer(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{path: path, cassette: cassette, used: make([]bool, len(cassette.Interactions))}, nil
+}
+
+// WithReplay serves the client's requests from rep. No request reaches the
+// network.
+func WithReplay(rep *Replayer) Option {
+	return func(c *Client) {
+		c.HttpClient.Transport = rep
+	}
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	in, ok := r.next(req.Method, req.URL.RequestURI())
+	if !ok {
+		return nil, fmt.Errorf("%w for %s %s in cassette %s", ErrNotRecorded, req.Method, req.URL.RequestURI(), r.path)
+	}
+	if in.Error != "" {
+		return nil, errors.New(in.Error)
+	}
+
+	body, err := decodeCassetteBody(in.Body, in.BodyEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body of %s %s in cassette %s: %w", req.Method, req.URL.RequestURI(), r.path, err)
+	}
+	header := in.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        strconv.Itoa(in.Status) + " " + http.StatusText(in.Status),
+		StatusCode:    in.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Replayer) next(method, url string) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if !r.used[i] && in.Method == method && in.URL == url {
+			r.used[i] = true
+			return in, true
+		}
+	}
+	return Interaction{}, false
+}
+
+// encodeCassetteBody returns body as stored in a cassette and its encoding,
+// see Interaction.
+func encodeCassetteBody(body []byte) (json.RawMessage, string) {
+	if len(body) == 0 {
+		return nil, ""
+	}
+	if json.Valid(body) {
+		return json.RawMessage(body), ""
+	}
+	if utf8.Valid(body) {
+		encoded, _ := json.Marshal(string(body))
+		return encoded, bodyEncodingText
+	}
+	encoded, _ := json.Marshal(base64.StdEncoding.EncodeToString(body))
+	return encoded, bodyEncodingBase64
+}
+
+// decodeCassetteBody reverses encodeCassetteBody.
+func decodeCassetteBody(body json.RawMessage, encoding string) ([]byte, error) {
+	if encoding == "" {
+		return body, nil
+	}
+	var text string
+	if err := json.Unmarshal(body, &text); err != nil {
+		return nil, fmt.Errorf("a %s body must be a JSON string: %w", encoding, err)
+	}
+	if encoding == bodyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(text)
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,222 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	srv := httptest.NewServer(fake.NewServer())
+	path := filepath.Join(t.TempDir(), "session.json")
+	ctx := context.Background()
+
+	recorder, err := NewRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("token-secret", WithBaseURL(srv.URL), WithRecorder(recorder))
+	if _, err := client.CreateDomain(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := client.GetDomains(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetDomain(ctx, "missing.com"); !IsNotFound(err) {
+		t.Fatalf("got error %v, want a 404", err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "token-secret") {
+		t.Error("the cassette contains the token")
+	}
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(cassette.Interactions); n != 3 {
+		t.Fatalf("recorded %d interactions, want 3", n)
+	}
+	if in := cassette.Interactions[0]; in.Method != "POST" || in.URL != "/dns/domains" || len(in.RequestBody) == 0 {
+		t.Errorf("got first interaction %s %s with body %s, want the domain create without the base URL", in.Method, in.URL, in.RequestBody)
+	}
+
+	// The server is gone, so every answer below comes from the cassette
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = NewClient("other-token", WithBaseURL("http://replay.invalid"), WithReplay(replayer))
+	if _, err := client.CreateDomain(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := client.GetDomains(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed.Data) != 1 || replayed.Data[0].Domain != recorded.Data[0].Domain {
+		t.Errorf("replayed %+v, want %+v", replayed.Data, recorded.Data)
+	}
+	if _, err := client.GetDomain(ctx, "missing.com"); !IsNotFound(err) {
+		t.Errorf("got error %v, want the recorded 404", err)
+	}
+
+	// Each interaction is replayed once
+	if _, err := client.GetDomains(ctx); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("got error %v, want ErrNotRecorded", err)
+	}
+}
+
+func TestReplayerNotRecordedIsNotRetried(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if _, err := NewRecorder(path); err != nil {
+		t.Fatal(err)
+	}
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("token", WithReplay(replayer), WithRetryPolicy(fastRetries))
+	if _, err := client.GetZoneList(context.Background()); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("got error %v, want ErrNotRecorded", err)
+	}
+}
+
+func TestLoadCassetteVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "future.json")
+	if err := os.WriteFile(path, []byte(`{"version": 99, "interactions": []}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCassette(path); err == nil || !strings.Contains(err.Error(), "unsupported cassette version") {
+		t.Errorf("got error %v, want an unsupported version", err)
+	}
+}
+
+func TestCassetteBodies(t *testing.T) {
+	bodies := map[string][]byte{
+		"/json":        []byte(`{"data": [1, 2]}`),
+		"/json-string": []byte(`"UP"`),
+		"/text":        []byte("not found\n"),
+		"/binary":      {0xff, 0x00, 0xfe, '"'},
+		"/empty":       nil,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(bodies[r.URL.Path])
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "bodies.json")
+	recorder, err := NewRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(client *http.Client, base, p string) []byte {
+		t.Helper()
+		resp, err := client.Get(base + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return body
+	}
+	recording := &http.Client{Transport: &recordingTransport{recorder: recorder}}
+	for p := range bodies {
+		get(recording, srv.URL, p)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodings := map[string]string{}
+	for _, in := range cassette.Interactions {
+		encodings[in.URL] = in.BodyEncoding
+	}
+	want := map[string]string{"/json": "", "/json-string": "", "/text": "text", "/binary": "base64", "/empty": ""}
+	for p, encoding := range want {
+		if encodings[p] != encoding {
+			t.Errorf("got encoding %q for %s, want %q", encodings[p], p, encoding)
+		}
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaying := &http.Client{Transport: replayer}
+	for p, body := range bodies {
+		got := get(replaying, "http://replay.invalid", p)
+		// JSON bodies are indented with the cassette
+		if json.Valid(body) {
+			got, body = compactJSON(t, got), compactJSON(t, body)
+		}
+		if !bytes.Equal(got, body) {
+			t.Errorf("replayed %q for %s, want %q", got, p, body)
+		}
+	}
+}
+
+func compactJSON(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	if err := json.Compact(&b, data); err != nil {
+		t.Fatalf("%q: %v", data, err)
+	}
+	return b.Bytes()
+}
+
+func TestLoadCassetteVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "v1.json")
+	v1 := `{"version": 1, "interactions": [
+		{"method": "GET", "url": "/text", "status": 404, "body": "not found"},
+		{"method": "GET", "url": "/json", "status": 200, "body": {"data": []}}
+	]}`
+	if err := os.WriteFile(path, []byte(v1), 0600); err != nil {
+		t.Fatal(err)
+	}
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: replayer}
+	for url, want := range map[string]string{"/text": "not found", "/json": `{"data": []}`} {
+		resp, err := client.Get("http://replay.invalid" + url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != want {
+			t.Errorf("replayed %q for %s, want %q", body, url, want)
+		}
+	}
+}
+
+func TestLoadCassetteBodyEncoding(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gzip.json")
+	cassette := `{"version": 2, "interactions": [{"method": "GET", "url": "/", "body": "H4sI", "bodyEncoding": "gzip"}]}`
+	if err := os.WriteFile(path, []byte(cassette), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCassette(path); err == nil || !strings.Contains(err.Error(), `unsupported body encoding "gzip"`) {
+		t.Errorf("got error %v, want an unsupported encoding", err)
+	}
+}
@@ -2,40 +2,156 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	urls "github.com/virak-cloud/cli/pkg"
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
+// DefaultUserAgent is sent with every request unless overridden with WithUserAgent.
+const DefaultUserAgent = "virak-cli"
+
 type Client struct {
 	HttpClient *http.Client
 	Token      string
 	BaseURL    string
+	UserAgent  string
+	Retry      RetryPolicy
+
+	limiter *limiter
+	refresh TokenRefresher
+	// tokenMu guards Token, which may be replaced by refresh.
+	tokenMu sync.Mutex
+}
+
+// Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithBaseURL points the client at a different API endpoint, e.g. a staging
+// environment or an httptest.Server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.BaseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient replaces the underlying *http.Client with a copy of
+// httpClient, so options such as WithTimeout and WithTransport do not change a
+// client shared with others, e.g. http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			copied := *httpClient
+			c.HttpClient = &copied
+		}
+	}
 }
 
-func NewClient(token string) *Client {
-	return &Client{
+// WithTimeout sets the overall timeout of every request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.HttpClient.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithTransport sets the RoundTripper used by the underlying *http.Client.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.HttpClient.Transport = transport
+	}
+}
+
+// NewClient creates an API client authenticated with token. Without options it
+// talks to urls.BaseUrl using a default *http.Client.
+func NewClient(token string, opts ...Option) *Client {
+	client := &Client{
 		HttpClient: &http.Client{},
 		Token:      token,
-		BaseURL:    "http://localhost:1410",
+		BaseURL:    strings.TrimRight(urls.BaseUrl, "/"),
+		UserAgent:  DefaultUserAgent,
+		Retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(client)
 	}
+	return client
 }
 
 // handleRequest is a generic helper to execute HTTP requests and decode responses.
-func (client *Client) handleRequest(method string, path string, body io.Reader, target interface{}) error {
-	req, err := http.NewRequest(method, path, body)
+// Failed attempts are retried according to client.Retry.
+func (client *Client) handleRequest(ctx context.Context, method string, path string, body io.Reader, target interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		token := client.currentToken()
+		err := client.doRequest(ctx, method, path, token, payload, target)
+		if IsUnauthorized(err) && !refreshed && client.refreshToken(ctx, token) {
+			refreshed = true
+			attempt--
+			continue
+		}
+		wait, retry := client.Retry.backoff(ctx, method, attempt, err)
+		if !retry {
+			return err
+		}
+		slog.Warn("retrying API request", "method", method, "url", path, "attempt", attempt+1, "wait", wait, "error", err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// doRequest performs a single attempt of a request.
+func (client *Client) doRequest(ctx context.Context, method string, path string, token string, payload []byte, target interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, path, body)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	if client.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+client.Token)
+	if client.UserAgent != "" {
+		req.Header.Set("User-Agent", client.UserAgent)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	release, err := client.limiter.acquire(ctx, method, path)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
 	}
+	defer release()
 
 	resp, err := client.HttpClient.Do(req)
 	if err != nil {
@@ -49,12 +165,15 @@ func (client *Client) handleRequest(method string, path string, body io.Reader,
 	}
 
 	if resp.StatusCode >= 400 {
+		// Fall back to the raw body if the error response isn't the expected JSON
+		var decoded *responses.ErrorResponse
 		var apiError responses.ErrorResponse
-		if err := json.Unmarshal(respBody, &apiError); err != nil {
-			// Fallback if the error response isn't the expected JSON
-			return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
+		if err := json.Unmarshal(respBody, &apiError); err == nil {
+			decoded = &apiError
 		}
-		return fmt.Errorf("API error: %s", respBody)
+		apiErr := newAPIError(method, path, resp.StatusCode, respBody, decoded)
+		apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		return apiErr
 	}
 
 	if target != nil {
@@ -66,8 +185,8 @@ func (client *Client) handleRequest(method string, path string, body io.Reader,
 	return nil
 }
 
-func (client *Client) Request(method, url string, body []byte) ([]byte, error) {
+func (client *Client) Request(ctx context.Context, method, url string, body []byte) ([]byte, error) {
 	var responseBody []byte
-	err := client.handleRequest(method, url, bytes.NewBuffer(body), &responseBody)
+	err := client.handleRequest(ctx, method, url, bytes.NewBuffer(body), &responseBody)
 	return responseBody, err
 }
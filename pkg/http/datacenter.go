@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	urls "github.com/virak-cloud/cli/pkg"
 	"github.com/virak-cloud/cli/pkg/http/responses"
@@ -8,11 +9,11 @@ import (
 )
 
 // GetZoneList fetches the list of zones and returns a ZoneListResponse struct.
-func (client *Client) GetZoneList() (*responses.DataCenter, error) {
+func (client *Client) GetZoneList(ctx context.Context) (*responses.DataCenter, error) {
 	var result responses.DataCenter
-	url := fmt.Sprintf(urls.ZoneList, urls.BaseUrl)
+	url := fmt.Sprintf(urls.ZoneList, client.BaseURL)
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -20,10 +21,10 @@ func (client *Client) GetZoneList() (*responses.DataCenter, error) {
 }
 
 // GetZoneActiveServices fetches the active services for a specific zone.
-func (client *Client) GetZoneActiveServices(zoneID string) (*responses.ZoneActiveServicesResponse, error) {
+func (client *Client) GetZoneActiveServices(ctx context.Context, zoneID string) (*responses.ZoneActiveServicesResponse, error) {
 	var result responses.ZoneActiveServicesResponse
-	url := fmt.Sprintf(urls.ZoneActiveServicesList, urls.BaseUrl, zoneID)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.ZoneActiveServicesList, client.BaseURL, zoneID)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +32,10 @@ func (client *Client) GetZoneActiveServices(zoneID string) (*responses.ZoneActiv
 }
 
 // GetZoneCustomerResource fetches the customer resource for a specific zone.
-func (client *Client) GetZoneCustomerResource(zoneID string) (*responses.CustomerResourceResponse, error) {
+func (client *Client) GetZoneCustomerResource(ctx context.Context, zoneID string) (*responses.CustomerResourceResponse, error) {
 	var result responses.CustomerResourceResponse
-	url := fmt.Sprintf(urls.ZoneResourcesList, urls.BaseUrl, zoneID)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.ZoneResourcesList, client.BaseURL, zoneID)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +43,10 @@ func (client *Client) GetZoneCustomerResource(zoneID string) (*responses.Custome
 }
 
 // GetZoneNetworks fetches the networks for a specific zone.
-func (client *Client) GetZoneNetworks(zoneID string) (*responses.ZoneNetworksResponse, error) {
+func (client *Client) GetZoneNetworks(ctx context.Context, zoneID string) (*responses.ZoneNetworksResponse, error) {
 	var result responses.ZoneNetworksResponse
-	url := fmt.Sprintf(urls.ZoneNetworkList, urls.BaseUrl, zoneID)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.ZoneNetworkList, client.BaseURL, zoneID)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
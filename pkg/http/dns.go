@@ -2,8 +2,10 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	s "strings"
 
@@ -12,32 +14,32 @@ import (
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func (client *Client) GetDomains() (*responses.DomainList, error) {
-	url := fmt.Sprintf(urls.DomainListURL, urls.BaseUrl)
+func (client *Client) GetDomains(ctx context.Context) (*responses.DomainList, error) {
+	url := fmt.Sprintf(urls.DomainListURL, client.BaseURL)
 	var domainList responses.DomainList
-	err := client.handleRequest(http.MethodGet, url, nil, &domainList)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &domainList)
 	if err != nil {
 		return nil, err
 	}
 	return &domainList, nil
 }
 
-func (client *Client) CreateDomain(domain string) (*responses.DnsMessage, error) {
-	url := fmt.Sprintf(urls.DomainCreateURL, urls.BaseUrl)
+func (client *Client) CreateDomain(ctx context.Context, domain string) (*responses.DnsMessage, error) {
+	url := fmt.Sprintf(urls.DomainCreateURL, client.BaseURL)
 	body := []byte(fmt.Sprintf(`{"domain": "%s"}`, domain))
 	var message responses.DnsMessage
-	err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &message)
+	err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &message)
 	if err != nil {
 		return nil, err
 	}
 	return &message, nil
 }
 
-func (client *Client) GetDomain(domain string) (*responses.DomainShow, error) {
-	url := fmt.Sprintf(urls.DomainShowURL, urls.BaseUrl, domain)
+func (client *Client) GetDomain(ctx context.Context, domain string) (*responses.DomainShow, error) {
+	url := fmt.Sprintf(urls.DomainShowURL, client.BaseURL, domain)
 	var domainShow responses.DomainShow
 
-	err := client.handleRequest(http.MethodGet, url, nil, &domainShow)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &domainShow)
 
 	if err != nil {
 		if s.Contains(err.Error(), "cannot unmarshal array into Go struct field DomainShow.data of type responses.Domain") {
@@ -50,28 +52,28 @@ func (client *Client) GetDomain(domain string) (*responses.DomainShow, error) {
 	return &domainShow, nil
 }
 
-func (client *Client) DeleteDomain(domain string) (*responses.DnsMessage, error) {
-	url := fmt.Sprintf(urls.DomainDeleteURL, urls.BaseUrl, domain)
+func (client *Client) DeleteDomain(ctx context.Context, domain string) (*responses.DnsMessage, error) {
+	url := fmt.Sprintf(urls.DomainDeleteURL, client.BaseURL, domain)
 	var message responses.DnsMessage
-	err := client.handleRequest(http.MethodDelete, url, nil, &message)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &message)
 	if err != nil {
 		return nil, err
 	}
 	return &message, nil
 }
 
-func (client *Client) GetRecords(domain string) (*responses.RecordList, error) {
-	url := fmt.Sprintf(urls.RecordListURL, urls.BaseUrl, domain)
+func (client *Client) GetRecords(ctx context.Context, domain string) (*responses.RecordList, error) {
+	url := fmt.Sprintf(urls.RecordListURL, client.BaseURL, domain)
 	var recordList responses.RecordList
-	err := client.handleRequest(http.MethodGet, url, nil, &recordList)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &recordList)
 	if err != nil {
 		return nil, err
 	}
 	return &recordList, nil
 }
 
-func (client *Client) CreateRecord(domain, record, recordType, content string, ttl, priority, weight, port, flags int, tag string, license, choicer, match int) (*responses.DnsMessage, error) {
-	url := fmt.Sprintf(urls.RecordCreateURL, urls.BaseUrl, domain)
+func (client *Client) CreateRecord(ctx context.Context, domain, record, recordType, content string, ttl, priority, weight, port, flags int, tag string, license, choicer, match int) (*responses.DnsMessage, error) {
+	url := fmt.Sprintf(urls.RecordCreateURL, client.BaseURL, domain)
 
 	// Base fields that are always included
 	bodyMap := map[string]interface{}{
@@ -103,15 +105,15 @@ func (client *Client) CreateRecord(domain, record, recordType, content string, t
 		return nil, err
 	}
 	var message responses.DnsMessage
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &message)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &message)
 	if err != nil {
 		return nil, err
 	}
 	return &message, nil
 }
 
-func (client *Client) UpdateRecord(domain, record, recordType, contentId, newContent string, newTTL, priority, weight, port, flags int, tag string, license, choicer, match int) (*responses.DnsMessage, error) {
-	url := fmt.Sprintf(urls.RecordUpdateURL, urls.BaseUrl, domain, record, recordType, contentId)
+func (client *Client) UpdateRecord(ctx context.Context, domain, record, recordType, contentId, newContent string, newTTL, priority, weight, port, flags int, tag string, license, choicer, match int) (*responses.DnsMessage, error) {
+	url := fmt.Sprintf(urls.RecordUpdateURL, client.BaseURL, domain, record, recordType, contentId)
 
 	// Base fields that are always included
 	bodyMap := map[string]interface{}{
@@ -142,7 +144,7 @@ func (client *Client) UpdateRecord(domain, record, recordType, contentId, newCon
 	}
 
 	var message responses.DnsMessage
-	err = client.handleRequest(http.MethodPut, url, bytes.NewBuffer(body), &message)
+	err = client.handleRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body), &message)
 	if err != nil {
 		return nil, err
 	}
@@ -150,22 +152,33 @@ func (client *Client) UpdateRecord(domain, record, recordType, contentId, newCon
 	return &message, nil
 }
 
-func (client *Client) DeleteRecord(domain, record, recordType, contentId string) (*responses.DnsMessage, error) {
-	url := fmt.Sprintf(urls.RecordDeleteURL, urls.BaseUrl, domain, record, recordType, contentId)
+func (client *Client) DeleteRecord(ctx context.Context, domain, record, recordType, contentId string) (*responses.DnsMessage, error) {
+	url := fmt.Sprintf(urls.RecordDeleteURL, client.BaseURL, domain, record, recordType, contentId)
 	var message responses.DnsMessage
-	err := client.handleRequest(http.MethodDelete, url, nil, &message)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &message)
 	if err != nil {
 		return nil, err
 	}
 	return &message, nil
 }
 
-func (client *Client) GetDNSEvents() (*responses.DNSEventsResponse, error) {
-	url := fmt.Sprintf(urls.DNSEventsURL, urls.BaseUrl)
+func (client *Client) GetDNSEvents(ctx context.Context, page PageOptions) (*responses.DNSEventsResponse, error) {
+	url := page.apply(fmt.Sprintf(urls.DNSEventsURL, client.BaseURL))
 	var dnsEvents responses.DNSEventsResponse
-	err := client.handleRequest(http.MethodGet, url, nil, &dnsEvents)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &dnsEvents)
 	if err != nil {
 		return nil, err
 	}
 	return &dnsEvents, nil
 }
+
+// AllDNSEvents lazily iterates over DNS events across all pages, starting at start.Page.
+func (client *Client) AllDNSEvents(ctx context.Context, start PageOptions) iter.Seq2[responses.DNSEvent, error] {
+	return Paginate(ctx, start, func(ctx context.Context, page PageOptions) ([]responses.DNSEvent, responses.Meta, error) {
+		res, err := client.GetDNSEvents(ctx, page)
+		if err != nil {
+			return nil, responses.Meta{}, err
+		}
+		return res.Data, res.Meta, nil
+	})
+}
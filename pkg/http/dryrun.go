@@ -0,0 +1,65 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// ErrDryRun is returned for the requests WithDryRun printed instead of
+// sending them.
+var ErrDryRun = errors.New("dry run, request not sent")
+
+// WithDryRun prints every request that would change something to w instead of
+// sending it, and fails it with ErrDryRun. GET and HEAD requests are still
+// sent, so commands can look up what they act on. Credentials are redacted as
+// in trace logs.
+func WithDryRun(w io.Writer) Option {
+	return func(c *Client) {
+		c.HttpClient.Transport = &dryRunTransport{base: c.HttpClient.Transport, w: w}
+	}
+}
+
+type dryRunTransport struct {
+	base http.RoundTripper
+	w    io.Writer
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		base := t.base
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		return base.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	fmt.Fprintf(t.w, "%s %s\n", req.Method, req.URL)
+	headers := RedactHeaders(req.Header)
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range headers[name] {
+			fmt.Fprintf(t.w, "%s: %s\n", name, value)
+		}
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(body)
+			body.Close()
+			if len(payload) > 0 {
+				fmt.Fprintf(t.w, "\n%s\n", RedactBody(payload))
+			}
+		}
+	}
+	fmt.Fprintln(t.w)
+	return nil, ErrDryRun
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// APIError is returned by the client whenever the API answers with a 4xx or 5xx status.
+// Use errors.As to inspect it, or the Is* helpers for the common cases.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Message    string
+	// Errors holds per-field validation messages, keyed by field name.
+	Errors map[string][]string
+	Code   int
+	// RetryAfter is the delay requested by the server's Retry-After header, if any.
+	RetryAfter time.Duration
+	// Body is the raw response body, kept for responses that are not JSON.
+	Body []byte
+}
+
+func newAPIError(method, url string, statusCode int, body []byte, decoded *responses.ErrorResponse) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		URL:        url,
+		Body:       body,
+	}
+	if decoded != nil {
+		apiErr.Message = decoded.Message
+		apiErr.Code = decoded.Code
+		apiErr.Errors = normalizeFieldErrors(decoded.Errors)
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "API error: %s %s: status %d", e.Method, e.URL, e.StatusCode)
+	switch {
+	case e.Message != "":
+		fmt.Fprintf(&b, ": %s", e.Message)
+	case len(e.Body) > 0:
+		fmt.Fprintf(&b, ", body: %s", string(e.Body))
+	}
+	for _, field := range e.Fields() {
+		fmt.Fprintf(&b, "\n  - %s: %s", field, strings.Join(e.Errors[field], "; "))
+	}
+	return b.String()
+}
+
+// Fields returns the names of the fields with validation errors in a stable order.
+func (e *APIError) Fields() []string {
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// normalizeFieldErrors converts the loosely typed "errors" member of an error
+// response into a field -> messages map. Unknown shapes are dropped.
+func normalizeFieldErrors(raw interface{}) map[string][]string {
+	m, ok := raw.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		return nil
+	}
+	out := make(map[string][]string, len(m))
+	for field, v := range m {
+		switch msgs := v.(type) {
+		case string:
+			out[field] = []string{msgs}
+		case []interface{}:
+			for _, msg := range msgs {
+				out[field] = append(out[field], fmt.Sprint(msg))
+			}
+		default:
+			out[field] = []string{fmt.Sprint(msgs)}
+		}
+	}
+	return out
+}
+
+// AsAPIError reports whether err wraps an *APIError and returns it.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+func hasStatus(err error, statuses ...int) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	for _, s := range statuses {
+		if apiErr.StatusCode == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an API 404 response.
+func IsNotFound(err error) bool { return hasStatus(err, http.StatusNotFound) }
+
+// IsUnauthorized reports whether err is an API 401 response.
+func IsUnauthorized(err error) bool { return hasStatus(err, http.StatusUnauthorized) }
+
+// IsForbidden reports whether err is an API 403 response.
+func IsForbidden(err error) bool { return hasStatus(err, http.StatusForbidden) }
+
+// IsValidation reports whether err is an API 422 response.
+func IsValidation(err error) bool { return hasStatus(err, http.StatusUnprocessableEntity) }
+
+// IsUnsupported reports whether err is an API 404, 405 or 501 response, i.e.
+// the API does not offer the requested operation.
+func IsUnsupported(err error) bool {
+	return hasStatus(err, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented)
+}
+
+// IsRateLimited reports whether err is an API 429 response.
+func IsRateLimited(err error) bool { return hasStatus(err, http.StatusTooManyRequests) }
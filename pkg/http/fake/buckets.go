@@ -0,0 +1,198 @@
+package fake
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Bucket statuses. BucketStatusActive in pkg/http matches bucketActive.
+const (
+	bucketCreating = "Creating"
+	bucketActive   = "Active"
+	bucketDeleting = "Deleting"
+)
+
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+func (s *Server) bucketRoutes() {
+	s.handle(http.MethodGet, urls.BucketList, s.listBuckets, "zoneId")
+	s.handle(http.MethodPost, urls.BucketCreate, s.createBucket, "zoneId")
+	s.handle(http.MethodGet, urls.BucketShow, s.showBucket, "zoneId", "bucketId")
+	s.handle(http.MethodPut, urls.BucketUpdate, s.updateBucket, "zoneId", "bucketId")
+	s.handle(http.MethodDelete, urls.BucketDelete, s.deleteBucket, "zoneId", "bucketId")
+	s.handle(http.MethodGet, urls.BucketsEventList, s.listBucketEvents, "zoneId")
+	s.handle(http.MethodGet, urls.BucketEventList, s.listBucketEvents, "zoneId", "bucketId")
+}
+
+// bucket returns the bucket named by the path, writing a 404 when it does not
+// exist.
+func (s *Server) bucket(w http.ResponseWriter, r *http.Request) (*bucket, bool) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return nil, false
+	}
+	id := r.PathValue("bucketId")
+	for _, b := range s.state.buckets {
+		if b.zoneID == zoneID && b.ID == id {
+			return b, true
+		}
+	}
+	writeNotFound(w, "Bucket")
+	return nil, false
+}
+
+// bucketEvent records an entry for the bucket events endpoints.
+func (s *Server) bucketEvent(b *bucket, eventType, content string) {
+	s.state.bucketEvents = append(s.state.bucketEvents, bucketEvent{
+		ObjectStorageEvent: responses.ObjectStorageEvent{
+			ProductModel:  "Bucket",
+			ProductID:     b.ID,
+			ProductSource: "object-storage",
+			Type:          eventType,
+			Content:       content,
+			CreatedAt:     int(time.Now().Unix()),
+		},
+		zoneID: b.zoneID,
+	})
+}
+
+func validatePolicy(errs fieldErrors, policy string) {
+	errs.require("policy", policy)
+	if policy != "" && policy != "Private" && policy != "Public" {
+		errs.add("policy", "The selected policy is invalid.")
+	}
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	res := responses.ObjectStorageBucketsResponse{Data: []responses.ObjectStorageBucket{}}
+	for _, b := range s.state.buckets {
+		if b.zoneID == zoneID {
+			res.Data = append(res.Data, b.ObjectStorageBucket)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name   string `json:"name"`
+		Policy string `json:"policy"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	if req.Name != "" && !bucketNamePattern.MatchString(req.Name) {
+		errs.add("name", "The name must be 3-63 lowercase letters, digits or hyphens.")
+	}
+	validatePolicy(errs, req.Policy)
+	// Bucket names are global, not per zone.
+	if slices.ContainsFunc(s.state.buckets, func(b *bucket) bool { return b.Name == req.Name }) {
+		errs.add("name", "The name has already been taken.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	region := strings.ToLower(s.instanceZone(zoneID).Name)
+	now := int(time.Now().Unix())
+	b := &bucket{
+		ObjectStorageBucket: responses.ObjectStorageBucket{
+			ID:        newID(),
+			Name:      req.Name,
+			URL:       fmt.Sprintf("https://%s.s3.%s.virakcloud.com", req.Name, region),
+			Region:    region,
+			AccessKey: rand.Text()[:20],
+			SecretKey: rand.Text(),
+			Status:    bucketCreating,
+			Policy:    req.Policy,
+			CreatedAt: now,
+			UpdatedAt: now,
+			Tier:      "Standard",
+		},
+		zoneID: zoneID,
+	}
+	s.state.buckets = append(s.state.buckets, b)
+	s.bucketEvent(b, "create", "Bucket created")
+	s.later(func() { b.Status = bucketActive })
+
+	writeSuccess(w)
+}
+
+func (s *Server) showBucket(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.ObjectStorageBucketResponse{Data: b.ObjectStorageBucket})
+	}
+}
+
+func (s *Server) updateBucket(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Policy string `json:"policy"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	validatePolicy(errs, req.Policy)
+	if errs.write(w) {
+		return
+	}
+
+	b.Policy = req.Policy
+	b.UpdatedAt = int(time.Now().Unix())
+	s.bucketEvent(b, "update", "Bucket policy changed to "+req.Policy)
+	writeJSON(w, http.StatusOK, responses.ObjectStorageBucketResponse{Data: b.ObjectStorageBucket})
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	b.Status = bucketDeleting
+	s.bucketEvent(b, "delete", "Bucket deleted")
+	s.later(func() {
+		s.state.buckets = slices.DeleteFunc(s.state.buckets, func(other *bucket) bool { return other == b })
+	})
+	writeSuccess(w)
+}
+
+// listBucketEvents serves both the zone-wide and the per-bucket event list.
+func (s *Server) listBucketEvents(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	bucketID := r.PathValue("bucketId")
+	events := []responses.ObjectStorageEvent{}
+	for i := len(s.state.bucketEvents) - 1; i >= 0; i-- {
+		e := s.state.bucketEvents[i]
+		if e.zoneID == zoneID && (bucketID == "" || e.ProductID == bucketID) {
+			events = append(events, e.ObjectStorageEvent)
+		}
+	}
+	page, meta := paginate(r, events)
+	writeJSON(w, http.StatusOK, responses.ObjectStorageEventsResponse{Data: page, Meta: meta})
+}
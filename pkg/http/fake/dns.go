@@ -0,0 +1,282 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Domain and record statuses.
+const (
+	domainPending = "pending"
+	domainActive  = "active"
+	recordActive  = "active"
+)
+
+// virakNameservers are the nameservers a domain must be delegated to.
+var virakNameservers = []string{"ns1.virakcloud.com", "ns2.virakcloud.com"}
+
+func (s *Server) dnsRoutes() {
+	s.handle(http.MethodGet, urls.DomainListURL, s.listDomains)
+	s.handle(http.MethodPost, urls.DomainCreateURL, s.createDomain)
+	s.handle(http.MethodGet, urls.DomainShowURL, s.showDomain, "domain")
+	s.handle(http.MethodDelete, urls.DomainDeleteURL, s.deleteDomain, "domain")
+	s.handle(http.MethodGet, urls.DNSEventsURL, s.listDNSEvents)
+	s.handle(http.MethodGet, urls.RecordListURL, s.listRecords, "domain")
+	s.handle(http.MethodPost, urls.RecordCreateURL, s.createRecord, "domain")
+	s.handle(http.MethodPut, urls.RecordUpdateURL, s.updateRecord, "domain", "record", "type", "contentId")
+	s.handle(http.MethodDelete, urls.RecordDeleteURL, s.deleteRecord, "domain", "record", "type", "contentId")
+}
+
+// domain returns the domain named by the path, writing a 404 when it does not
+// exist.
+func (s *Server) domain(w http.ResponseWriter, r *http.Request) (*domain, bool) {
+	name := r.PathValue("domain")
+	for _, d := range s.state.domains {
+		if d.Domain.Domain == name {
+			return d, true
+		}
+	}
+	writeNotFound(w, "Domain")
+	return nil, false
+}
+
+// dnsEvent records an entry for the DNS events endpoint.
+func (s *Server) dnsEvent(domain, eventType, content string) {
+	s.state.dnsEvents = append(s.state.dnsEvents, responses.DNSEvent{
+		ProductModel:  "Domain",
+		ProductID:     domain,
+		ProductSource: "dns",
+		Type:          eventType,
+		Content:       content,
+		CreatedAt:     int(time.Now().Unix()),
+	})
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	res := responses.DomainList{Data: []responses.Domain{}}
+	for _, d := range s.state.domains {
+		res.Data = append(res.Data, d.Domain)
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Domain string `json:"domain"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	req.Domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(req.Domain), "."))
+
+	errs := fieldErrors{}
+	errs.require("domain", req.Domain)
+	if req.Domain != "" && !strings.Contains(req.Domain, ".") {
+		errs.add("domain", "The domain must be a valid domain name.")
+	}
+	if slices.ContainsFunc(s.state.domains, func(d *domain) bool { return d.Domain.Domain == req.Domain }) {
+		errs.add("domain", "The domain has already been taken.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	d := &domain{Domain: responses.Domain{Domain: req.Domain, Status: domainPending}}
+	for _, ns := range virakNameservers {
+		d.DNSInfo.VirakDNS = append(d.DNSInfo.VirakDNS, &ns)
+	}
+	d.DNSInfo.DomainDNS = []string{}
+	s.state.domains = append(s.state.domains, d)
+	s.dnsEvent(d.Domain.Domain, "create", "Domain created")
+	s.later(func() {
+		d.Status = domainActive
+		d.DNSInfo.DomainDNS = slices.Clone(virakNameservers)
+	})
+
+	writeMessage(w, "Domain created successfully.")
+}
+
+func (s *Server) showDomain(w http.ResponseWriter, r *http.Request) {
+	if d, ok := s.domain(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.DomainShow{Data: d.Domain})
+	}
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.domain(w, r)
+	if !ok {
+		return
+	}
+	s.state.domains = slices.DeleteFunc(s.state.domains, func(other *domain) bool { return other == d })
+	s.dnsEvent(d.Domain.Domain, "delete", "Domain deleted")
+	writeMessage(w, "Domain deleted successfully.")
+}
+
+func (s *Server) listDNSEvents(w http.ResponseWriter, r *http.Request) {
+	// Newest events first, like the API.
+	events := slices.Clone(s.state.dnsEvents)
+	slices.Reverse(events)
+	page, meta := paginate(r, events)
+	writeJSON(w, http.StatusOK, responses.DNSEventsResponse{Data: page, Meta: meta})
+}
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.domain(w, r)
+	if !ok {
+		return
+	}
+	res := responses.RecordList{Data: []responses.Record{}}
+	for _, rec := range d.records {
+		res.Data = append(res.Data, *rec)
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// recordRequest is the body of the record create and update endpoints.
+type recordRequest struct {
+	Record   string `json:"record"`
+	Type     string `json:"type"`
+	TTL      int    `json:"ttl"`
+	Content  string `json:"content"`
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+	Port     int    `json:"port"`
+	Flags    int    `json:"flags"`
+	Tag      string `json:"tag"`
+	License  int    `json:"license"`
+	Choicer  int    `json:"choicer"`
+	Match    int    `json:"match"`
+}
+
+// raw renders the content the way the API reports it, prefixed with the
+// type-specific fields.
+func (req recordRequest) raw(recordType string) string {
+	switch recordType {
+	case "MX":
+		return fmt.Sprintf("%d %s", req.Priority, req.Content)
+	case "SRV":
+		return fmt.Sprintf("%d %d %d %s", req.Priority, req.Weight, req.Port, req.Content)
+	case "CAA":
+		return fmt.Sprintf("%d %s %q", req.Flags, req.Tag, req.Content)
+	case "TLSA":
+		return fmt.Sprintf("%d %d %d %s", req.License, req.Choicer, req.Match, req.Content)
+	}
+	return req.Content
+}
+
+var recordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SRV", "CAA", "TLSA"}
+
+func (s *Server) createRecord(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.domain(w, r)
+	if !ok {
+		return
+	}
+	var req recordRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("record", req.Record)
+	errs.require("type", req.Type)
+	errs.require("content", req.Content)
+	if req.Type != "" && !slices.Contains(recordTypes, req.Type) {
+		errs.add("type", "The selected type is invalid.")
+	}
+	if req.TTL <= 0 {
+		errs.add("ttl", "The ttl must be at least 1.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	content := responses.Content{ID: newID(), ContentRaw: req.raw(req.Type)}
+	i := slices.IndexFunc(d.records, func(rec *responses.Record) bool {
+		return rec.Name == req.Record && rec.Type == req.Type
+	})
+	if i >= 0 {
+		d.records[i].Content = append(d.records[i].Content, content)
+		d.records[i].TTL = req.TTL
+	} else {
+		d.records = append(d.records, &responses.Record{
+			Name:    req.Record,
+			TTL:     req.TTL,
+			Type:    req.Type,
+			Status:  recordActive,
+			Content: []responses.Content{content},
+		})
+	}
+	s.dnsEvent(d.Domain.Domain, "record_create", fmt.Sprintf("%s record %s created", req.Type, req.Record))
+	writeMessage(w, "Record created successfully.")
+}
+
+// record returns the record and content index named by the path, writing a
+// 404 when either does not exist.
+func (s *Server) record(w http.ResponseWriter, r *http.Request) (*domain, *responses.Record, int, bool) {
+	d, ok := s.domain(w, r)
+	if !ok {
+		return nil, nil, 0, false
+	}
+	name, recordType, contentID := r.PathValue("record"), r.PathValue("type"), r.PathValue("contentId")
+	for _, rec := range d.records {
+		if rec.Name != name || rec.Type != recordType {
+			continue
+		}
+		if i := slices.IndexFunc(rec.Content, func(c responses.Content) bool { return c.ID == contentID }); i >= 0 {
+			return d, rec, i, true
+		}
+	}
+	writeNotFound(w, "Record")
+	return nil, nil, 0, false
+}
+
+func (s *Server) updateRecord(w http.ResponseWriter, r *http.Request) {
+	d, rec, i, ok := s.record(w, r)
+	if !ok {
+		return
+	}
+	if rec.IsProtected {
+		writeError(w, http.StatusForbidden, "Protected records cannot be changed.")
+		return
+	}
+	var req recordRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("content", req.Content)
+	if req.TTL <= 0 {
+		errs.add("ttl", "The ttl must be at least 1.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	rec.TTL = req.TTL
+	rec.Content[i].ContentRaw = req.raw(rec.Type)
+	s.dnsEvent(d.Domain.Domain, "record_update", fmt.Sprintf("%s record %s updated", rec.Type, rec.Name))
+	writeMessage(w, "Record updated successfully.")
+}
+
+func (s *Server) deleteRecord(w http.ResponseWriter, r *http.Request) {
+	d, rec, i, ok := s.record(w, r)
+	if !ok {
+		return
+	}
+	if rec.IsProtected {
+		writeError(w, http.StatusForbidden, "Protected records cannot be deleted.")
+		return
+	}
+	rec.Content = slices.Delete(rec.Content, i, i+1)
+	if len(rec.Content) == 0 {
+		d.records = slices.DeleteFunc(d.records, func(other *responses.Record) bool { return other == rec })
+	}
+	s.dnsEvent(d.Domain.Domain, "record_delete", fmt.Sprintf("%s record %s deleted", rec.Type, rec.Name))
+	writeMessage(w, "Record deleted successfully.")
+}
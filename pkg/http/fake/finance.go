@@ -0,0 +1,89 @@
+package fake
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// expensesPath is not part of pkg/urls.go; pkg/http builds it inline.
+const expensesPath = "/user/finance/expenses"
+
+func (s *Server) financeRoutes() {
+	s.handle(http.MethodGet, urls.UserBalance, s.wallet)
+	s.handle(http.MethodGet, urls.UserPaymentList, s.listPayments)
+	s.handle(http.MethodGet, urls.UserCostDocumentList, s.listDocuments)
+	s.handle(http.MethodPost, urls.UserCostDocumentList, s.listDocuments)
+	s.mux.HandleFunc(http.MethodGet+" "+expensesPath, s.listExpenses)
+}
+
+func (s *Server) wallet(w http.ResponseWriter, r *http.Request) {
+	res := s.state.Wallet
+	for _, e := range s.state.expenses {
+		res.Data.Balance -= e.Amount
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) listPayments(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, responses.PaymentListResponse{Data: s.state.Payments})
+}
+
+// listDocuments returns the cost documents of a year, read from the year query
+// parameter (GET) or the JSON body (POST).
+func (s *Server) listDocuments(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Year int `json:"year"`
+	}
+	if r.Method == http.MethodPost {
+		if !decode(w, r, &req) {
+			return
+		}
+	} else {
+		req.Year, _ = strconv.Atoi(r.URL.Query().Get("year"))
+	}
+	if req.Year <= 0 {
+		errs := fieldErrors{}
+		errs.add("year", "The year field is required.")
+		errs.write(w)
+		return
+	}
+
+	res := responses.CostDocumentsYearlyResponse{Data: []responses.CostDocument{}}
+	prefix := strconv.Itoa(req.Year) + "-"
+	for _, doc := range s.state.Documents {
+		if strings.HasPrefix(doc.DateFrom, prefix) {
+			res.Data = append(res.Data, doc)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// listExpenses returns the expenses of one product, optionally filtered by
+// date range and type, one page at a time.
+func (s *Server) listExpenses(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	errs := fieldErrors{}
+	errs.require("product_type", q.Get("product_type"))
+	errs.require("product_id", q.Get("product_id"))
+	if errs.write(w) {
+		return
+	}
+
+	start, end, expenseType := q.Get("start_date"), q.Get("end_date"), q.Get("type")
+	expenses := []responses.Expense{}
+	for _, e := range s.state.expenses {
+		switch {
+		case e.productType != q.Get("product_type"), e.productID != q.Get("product_id"):
+		case start != "" && e.Date < start, end != "" && e.Date > end:
+		case expenseType != "" && e.Type != expenseType:
+		default:
+			expenses = append(expenses, e.Expense)
+		}
+	}
+	page, meta := paginate(r, expenses)
+	writeJSON(w, http.StatusOK, responses.ExpensesListResponse{Data: page, Meta: meta})
+}
@@ -0,0 +1,405 @@
+package fake
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Instance statuses. The final ones match the statuses pkg/http waits for.
+const (
+	instanceUp         = "UP"
+	instanceDown       = "DOWN"
+	instanceStarting   = "STARTING"
+	instanceStopping   = "STOPPING"
+	instanceRebooting  = "REBOOTING"
+	instanceRebuilding = "REBUILDING"
+	instanceDestroying = "DESTROYING"
+
+	snapshotWaiting = "WAITING"
+	snapshotReady   = "READY"
+)
+
+func (s *Server) instanceRoutes() {
+	s.handle(http.MethodGet, urls.InstanceList, s.listInstances, "zoneId")
+	s.handle(http.MethodGet, urls.InstanceServiceOfferingList, s.listServiceOfferings, "zoneId")
+	s.handle(http.MethodGet, urls.InstanceVMImageList, s.listVMImages, "zoneId")
+	s.handle(http.MethodPost, urls.InstanceCreate, s.createInstance, "zoneId")
+	s.handle(http.MethodGet, urls.InstanceShow, s.showInstance, "zoneId", "instanceId")
+	s.handle(http.MethodDelete, urls.InstanceDelete, s.deleteInstance, "zoneId", "instanceId")
+	s.handle(http.MethodPost, urls.InstanceStart, s.startInstance, "zoneId", "instanceId")
+	s.handle(http.MethodPost, urls.InstanceStop, s.stopInstance, "zoneId", "instanceId")
+	s.handle(http.MethodPost, urls.InstanceReboot, s.rebootInstance, "zoneId", "instanceId")
+	s.handle(http.MethodPost, urls.InstanceRebuild, s.rebuildInstance, "zoneId", "instanceId")
+	s.handle(http.MethodGet, urls.InstanceConsole, s.instanceConsole, "zoneId", "instanceId")
+	s.handle(http.MethodPost, urls.InstanceSnapshotCreateURL, s.createSnapshot, "zoneId", "instanceId")
+	s.handle(http.MethodDelete, urls.InstanceSnapshotDeleteURL, s.deleteSnapshot, "zoneId", "instanceId", "snapshotId")
+	s.handle(http.MethodPost, urls.InstanceSnapshotRevertURL, s.revertSnapshot, "zoneId", "instanceId", "snapshotId")
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	res := responses.InstanceListResponse{Data: []responses.Instance{}}
+	for _, inst := range s.state.instances {
+		if inst.ZoneID == zoneID {
+			res.Data = append(res.Data, *inst)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) listServiceOfferings(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.InstanceServiceOfferingListResponse{Data: s.state.ServiceOfferings})
+}
+
+func (s *Server) listVMImages(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.InstanceVMImageListResponse{Data: s.state.VMImages})
+}
+
+// instance returns the instance named by the path, writing a 404 when it does
+// not exist.
+func (s *Server) instance(w http.ResponseWriter, r *http.Request) (*responses.Instance, bool) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return nil, false
+	}
+	id := r.PathValue("instanceId")
+	for _, inst := range s.state.instances {
+		if inst.ZoneID == zoneID && inst.ID == id {
+			return inst, true
+		}
+	}
+	writeNotFound(w, "Instance")
+	return nil, false
+}
+
+func (s *Server) serviceOffering(id string) *responses.InstanceServiceOffering {
+	for i := range s.state.ServiceOfferings {
+		if s.state.ServiceOfferings[i].ID == id {
+			offering := s.state.ServiceOfferings[i]
+			return &offering
+		}
+	}
+	return nil
+}
+
+func (s *Server) vmImage(id string) *responses.InstanceVMImage {
+	for i := range s.state.VMImages {
+		if s.state.VMImages[i].ID == id {
+			image := s.state.VMImages[i]
+			return &image
+		}
+	}
+	return nil
+}
+
+func (s *Server) instanceZone(zoneID string) *responses.InstanceZone {
+	for _, z := range s.state.Zones.Data {
+		if z.ID == zoneID {
+			return &responses.InstanceZone{ID: z.ID, Name: z.Name, Location: z.Location, IsPublic: true, IsReady: z.Active}
+		}
+	}
+	return nil
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		ServiceOfferingID string   `json:"service_offering_id"`
+		VMImageID         string   `json:"vm_image_id"`
+		NetworkIDs        []string `json:"network_ids"`
+		Name              string   `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	errs.require("service_offering_id", req.ServiceOfferingID)
+	errs.require("vm_image_id", req.VMImageID)
+	offering := s.serviceOffering(req.ServiceOfferingID)
+	if req.ServiceOfferingID != "" && offering == nil {
+		errs.add("service_offering_id", "The selected service offering id is invalid.")
+	}
+	image := s.vmImage(req.VMImageID)
+	if req.VMImageID != "" && image == nil {
+		errs.add("vm_image_id", "The selected vm image id is invalid.")
+	}
+	if len(req.NetworkIDs) == 0 {
+		errs.add("network_ids", "The network ids field is required.")
+	}
+	var networks []*network
+	for _, id := range req.NetworkIDs {
+		n := s.findNetwork(zoneID, id)
+		if n == nil {
+			errs.add("network_ids", fmt.Sprintf("The selected network id %s is invalid.", id))
+			continue
+		}
+		networks = append(networks, n)
+	}
+	if slices.ContainsFunc(s.state.instances, func(i *responses.Instance) bool { return i.ZoneID == zoneID && i.Name == req.Name }) {
+		errs.add("name", "The name has already been taken.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	now := time.Now().Unix()
+	inst := &responses.Instance{
+		ID:                newID(),
+		CustomerID:        s.state.Profile.Data.ID,
+		Name:              req.Name,
+		ZoneID:            zoneID,
+		Created:           true,
+		VMImage:           image,
+		Zone:              s.instanceZone(zoneID),
+		ServiceOffering:   offering,
+		ServiceOfferingID: offering.ID,
+		Status:            instanceStarting,
+		InstanceStatus:    instanceStarting,
+		Password:          rand.Text()[:16],
+		Username:          "root",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Metadata:          []interface{}{},
+		DataVolumes:       []interface{}{},
+		Snapshot:          []responses.InstanceSnapshot{},
+	}
+	s.state.instances = append(s.state.instances, inst)
+	for i, n := range networks {
+		s.attach(n, inst, i == 0)
+	}
+	s.charge("Instance", inst.ID, fmt.Sprintf("Instance %s (first hour)", inst.Name), float64(offering.HourlyPrice.Up))
+	s.later(func() { setInstanceStatus(inst, instanceUp) })
+
+	writeSuccess(w)
+}
+
+func (s *Server) showInstance(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.InstanceShowResponse{Data: *inst})
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	if req.Name != "" && req.Name != inst.Name {
+		errs.add("name", "The name does not match the instance name.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	setInstanceStatus(inst, instanceDestroying)
+	s.later(func() {
+		s.state.instances = slices.DeleteFunc(s.state.instances, func(i *responses.Instance) bool { return i == inst })
+		for _, n := range s.state.networks {
+			n.detach(inst.ID)
+		}
+	})
+	writeSuccess(w)
+}
+
+// transition moves inst through pending to final. It rejects the request with a
+// 409 and returns false unless the instance has one of the allowed statuses.
+func (s *Server) transition(w http.ResponseWriter, inst *responses.Instance, allowed []string, pending, final string) bool {
+	if !slices.Contains(allowed, inst.Status) {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Instance is %s; the operation is not allowed in this state.", inst.Status))
+		return false
+	}
+	setInstanceStatus(inst, pending)
+	s.later(func() { setInstanceStatus(inst, final) })
+	writeSuccess(w)
+	return true
+}
+
+func (s *Server) startInstance(w http.ResponseWriter, r *http.Request) {
+	if inst, ok := s.instance(w, r); ok {
+		s.transition(w, inst, []string{instanceDown}, instanceStarting, instanceUp)
+	}
+}
+
+func (s *Server) stopInstance(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Forced bool `json:"forced"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	allowed := []string{instanceUp}
+	if req.Forced {
+		allowed = append(allowed, instanceStarting, instanceRebooting, instanceRebuilding)
+	}
+	s.transition(w, inst, allowed, instanceStopping, instanceDown)
+}
+
+func (s *Server) rebootInstance(w http.ResponseWriter, r *http.Request) {
+	if inst, ok := s.instance(w, r); ok {
+		s.transition(w, inst, []string{instanceUp}, instanceRebooting, instanceUp)
+	}
+}
+
+func (s *Server) rebuildInstance(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		VMImageID string `json:"vm_image_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("vm_image_id", req.VMImageID)
+	image := s.vmImage(req.VMImageID)
+	if req.VMImageID != "" && image == nil {
+		errs.add("vm_image_id", "The selected vm image id is invalid.")
+	}
+	if errs.write(w) {
+		return
+	}
+	if s.transition(w, inst, []string{instanceUp, instanceDown}, instanceRebuilding, instanceUp) {
+		inst.VMImage = image
+	}
+}
+
+func (s *Server) instanceConsole(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.Status != instanceUp {
+		writeError(w, http.StatusConflict, "The console is only available while the instance is UP.")
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.InstanceConsoleResponse{Data: responses.InstanceConsole{
+		URL: fmt.Sprintf("http://%s/console/%s", r.Host, inst.ID),
+	}})
+}
+
+func setInstanceStatus(inst *responses.Instance, status string) {
+	inst.Status = status
+	inst.InstanceStatus = status
+	inst.UpdatedAt = time.Now().Unix()
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	if errs.write(w) {
+		return
+	}
+
+	snap := responses.InstanceSnapshot{
+		ID:        newID(),
+		Name:      req.Name,
+		Status:    snapshotWaiting,
+		CreatedAt: time.Now().Unix(),
+	}
+	for _, existing := range inst.Snapshot {
+		if existing.Current {
+			parent := existing.ID
+			snap.ParentID = &parent
+		}
+	}
+	inst.Snapshot = append(inst.Snapshot, snap)
+	s.later(func() {
+		for i := range inst.Snapshot {
+			inst.Snapshot[i].Current = inst.Snapshot[i].ID == snap.ID
+			if inst.Snapshot[i].ID == snap.ID {
+				inst.Snapshot[i].Status = snapshotReady
+			}
+		}
+	})
+	writeSuccess(w)
+}
+
+// snapshotIndex returns the index of the snapshot named by the path, writing a 404
+// when it does not exist.
+func snapshotIndex(w http.ResponseWriter, r *http.Request, inst *responses.Instance) (int, bool) {
+	id := r.PathValue("snapshotId")
+	i := slices.IndexFunc(inst.Snapshot, func(s responses.InstanceSnapshot) bool { return s.ID == id })
+	if i < 0 {
+		writeNotFound(w, "Snapshot")
+		return 0, false
+	}
+	return i, true
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	i, ok := snapshotIndex(w, r, inst)
+	if !ok {
+		return
+	}
+	inst.Snapshot = slices.Delete(inst.Snapshot, i, i+1)
+	writeSuccess(w)
+}
+
+func (s *Server) revertSnapshot(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	i, ok := snapshotIndex(w, r, inst)
+	if !ok {
+		return
+	}
+	if inst.Snapshot[i].Status != snapshotReady {
+		writeError(w, http.StatusConflict, "The snapshot is not ready yet.")
+		return
+	}
+	for j := range inst.Snapshot {
+		inst.Snapshot[j].Current = j == i
+	}
+	writeSuccess(w)
+}
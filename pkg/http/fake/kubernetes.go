@@ -0,0 +1,300 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Cluster statuses. The final ones match the statuses pkg/http waits for.
+const (
+	clusterCreating   = "Creating"
+	clusterRunning    = "Running"
+	clusterStarting   = "Starting"
+	clusterStopping   = "Stopping"
+	clusterStopped    = "Stopped"
+	clusterScaling    = "Scaling"
+	clusterDestroying = "Destroying"
+)
+
+func (s *Server) kubernetesRoutes() {
+	s.handle(http.MethodGet, urls.KubernetesClusterList, s.listClusters, "zoneId")
+	s.handle(http.MethodPost, urls.KubernetesClusterCreate, s.createCluster, "zoneId")
+	s.handle(http.MethodGet, urls.KubernetesVersionsList, s.listKubernetesVersions, "zoneId")
+	s.handle(http.MethodGet, urls.KubernetesServiceOfferingsList, s.listKubernetesOfferings, "zoneId")
+	s.handle(http.MethodGet, urls.KubernetesServiceEvents, s.listClusterEvents, "zoneId")
+	s.handle(http.MethodGet, urls.KubernetesClusterShow, s.showCluster, "zoneId", "clusterId")
+	s.handle(http.MethodPut, urls.KubernetesClusterUpdate, s.updateCluster, "zoneId", "clusterId")
+	s.handle(http.MethodDelete, urls.KubernetesClusterDelete, s.deleteCluster, "zoneId", "clusterId")
+	s.handle(http.MethodPost, urls.KubernetesClusterStart, s.startCluster, "zoneId", "clusterId")
+	s.handle(http.MethodPost, urls.KubernetesClusterStop, s.stopCluster, "zoneId", "clusterId")
+	s.handle(http.MethodPost, urls.KubernetesClusterScale, s.scaleCluster, "zoneId", "clusterId")
+	s.handle(http.MethodGet, urls.KubernetesClusterEvents, s.listClusterEvents, "zoneId", "clusterId")
+}
+
+// cluster returns the cluster named by the path, writing a 404 when it does
+// not exist.
+func (s *Server) cluster(w http.ResponseWriter, r *http.Request) (*responses.KubernetesCluster, bool) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return nil, false
+	}
+	id := r.PathValue("clusterId")
+	for _, c := range s.state.clusters {
+		if c.ZoneID == zoneID && c.ID == id {
+			return c, true
+		}
+	}
+	writeNotFound(w, "Kubernetes cluster")
+	return nil, false
+}
+
+// clusterEvent records an entry for the Kubernetes event endpoints.
+func (s *Server) clusterEvent(c *responses.KubernetesCluster, message string) {
+	s.state.clusterEvents = append(s.state.clusterEvents, clusterEvent{
+		KubernetesEvent: responses.KubernetesEvent{
+			ID:        newID(),
+			Message:   fmt.Sprintf("Cluster %s: %s", c.Name, message),
+			Timestamp: int(time.Now().Unix()),
+		},
+		zoneID:    c.ZoneID,
+		clusterID: c.ID,
+	})
+}
+
+// setClusterStatus changes the status and logs it as an event.
+func (s *Server) setClusterStatus(c *responses.KubernetesCluster, status string) {
+	c.Status = status
+	c.UpdatedAt = int(time.Now().Unix())
+	s.clusterEvent(c, "status changed to "+status)
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	res := responses.KubernetesClusterListResponse{Data: []responses.KubernetesCluster{}}
+	for _, c := range s.state.clusters {
+		if c.ZoneID == zoneID {
+			res.Data = append(res.Data, *c)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) listKubernetesVersions(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.KubernetesVersionsListResponse{Data: s.state.KubernetesVersions})
+	}
+}
+
+func (s *Server) listKubernetesOfferings(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.KubernetesServiceOfferingsListResponse{Data: s.state.KubernetesOfferings})
+	}
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name                string `json:"name"`
+		Description         string `json:"description"`
+		KubernetesVersionID string `json:"kubernetes_version_id"`
+		ServiceOfferingID   string `json:"service_offering_id"`
+		HAEnabled           bool   `json:"ha_enabled"`
+		SSHKeyID            string `json:"sshkey_id"`
+		NetworkID           string `json:"network_id"`
+		ClusterSize         int    `json:"cluster_size"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	errs.require("kubernetes_version_id", req.KubernetesVersionID)
+	errs.require("service_offering_id", req.ServiceOfferingID)
+	errs.require("sshkey_id", req.SSHKeyID)
+	errs.require("network_id", req.NetworkID)
+	version := slices.IndexFunc(s.state.KubernetesVersions, func(v responses.KubernetesVersion) bool {
+		return v.ID == req.KubernetesVersionID && v.Enabled
+	})
+	if req.KubernetesVersionID != "" && version < 0 {
+		errs.add("kubernetes_version_id", "The selected kubernetes version id is invalid.")
+	}
+	offering := slices.IndexFunc(s.state.KubernetesOfferings, func(o responses.KubernetesServiceOffering) bool {
+		return o.ID == req.ServiceOfferingID
+	})
+	if req.ServiceOfferingID != "" && offering < 0 {
+		errs.add("service_offering_id", "The selected service offering id is invalid.")
+	}
+	if req.SSHKeyID != "" && !slices.ContainsFunc(s.state.SSHKeys, func(k responses.UserSSHKey) bool { return k.ID == req.SSHKeyID }) {
+		errs.add("sshkey_id", "The selected sshkey id is invalid.")
+	}
+	if req.NetworkID != "" && s.findNetwork(zoneID, req.NetworkID) == nil {
+		errs.add("network_id", "The selected network id is invalid.")
+	}
+	if req.ClusterSize < 1 {
+		errs.add("cluster_size", "The cluster size must be at least 1.")
+	}
+	if slices.ContainsFunc(s.state.clusters, func(c *responses.KubernetesCluster) bool { return c.ZoneID == zoneID && c.Name == req.Name }) {
+		errs.add("name", "The name has already been taken.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	now := int(time.Now().Unix())
+	c := &responses.KubernetesCluster{
+		ID:          newID(),
+		Name:        req.Name,
+		Description: req.Description,
+		ZoneID:      zoneID,
+		Status:      clusterCreating,
+		SSHKey:      req.SSHKeyID,
+		HAEnabled:   req.HAEnabled,
+		ClusterSize: req.ClusterSize,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	v := s.state.KubernetesVersions[version]
+	c.KubernetesVersion.ID, c.KubernetesVersion.Version, c.KubernetesVersion.Enabled = v.ID, v.Version, v.Enabled
+	o := s.state.KubernetesOfferings[offering]
+	c.ServiceOffering.ID, c.ServiceOffering.Name = o.ID, o.Name
+	s.state.clusters = append(s.state.clusters, c)
+	s.clusterEvent(c, "creation requested")
+	s.later(func() { s.setClusterStatus(c, clusterRunning) })
+
+	writeJSON(w, http.StatusOK, responses.KubernetesMessage{Message: "Kubernetes cluster creation started."})
+}
+
+func (s *Server) showCluster(w http.ResponseWriter, r *http.Request) {
+	if c, ok := s.cluster(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.KubernetesClusterResponse{Data: *c})
+	}
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.cluster(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	if errs.write(w) {
+		return
+	}
+
+	c.Name, c.Description = req.Name, req.Description
+	c.UpdatedAt = int(time.Now().Unix())
+	s.clusterEvent(c, "details updated")
+	writeJSON(w, http.StatusOK, responses.KubernetesClusterResponse{Data: *c})
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.cluster(w, r)
+	if !ok {
+		return
+	}
+	s.setClusterStatus(c, clusterDestroying)
+	s.later(func() {
+		s.state.clusters = slices.DeleteFunc(s.state.clusters, func(other *responses.KubernetesCluster) bool { return other == c })
+	})
+	writeJSON(w, http.StatusOK, responses.KubernetesMessage{Message: "Kubernetes cluster deletion started."})
+}
+
+// transitionCluster moves c through pending to final, rejecting the request
+// with a 409 unless the cluster has the required status.
+func (s *Server) transitionCluster(w http.ResponseWriter, c *responses.KubernetesCluster, required, pending, final string) {
+	if c.Status != required {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Kubernetes cluster is %s; it must be %s.", c.Status, required))
+		return
+	}
+	s.setClusterStatus(c, pending)
+	s.later(func() { s.setClusterStatus(c, final) })
+	writeJSON(w, http.StatusOK, responses.KubernetesClusterResponse{Data: *c})
+}
+
+func (s *Server) startCluster(w http.ResponseWriter, r *http.Request) {
+	if c, ok := s.cluster(w, r); ok {
+		s.transitionCluster(w, c, clusterStopped, clusterStarting, clusterRunning)
+	}
+}
+
+func (s *Server) stopCluster(w http.ResponseWriter, r *http.Request) {
+	if c, ok := s.cluster(w, r); ok {
+		s.transitionCluster(w, c, clusterRunning, clusterStopping, clusterStopped)
+	}
+}
+
+func (s *Server) scaleCluster(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.cluster(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		AutoScaling    bool `json:"auto_scaling"`
+		ClusterSize    int  `json:"cluster_size"`
+		MinClusterSize int  `json:"min_cluster_size"`
+		MaxClusterSize int  `json:"max_cluster_size"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	size := req.ClusterSize
+	if req.AutoScaling {
+		if req.MinClusterSize < 1 || req.MaxClusterSize < req.MinClusterSize {
+			errs.add("max_cluster_size", "The max cluster size must be at least the min cluster size.")
+		}
+		size = req.MinClusterSize
+	} else if req.ClusterSize < 1 {
+		errs.add("cluster_size", "The cluster size must be at least 1.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	if c.Status != clusterRunning {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Kubernetes cluster is %s; it must be %s.", c.Status, clusterRunning))
+		return
+	}
+	s.setClusterStatus(c, clusterScaling)
+	s.later(func() {
+		c.ClusterSize = size
+		s.setClusterStatus(c, clusterRunning)
+	})
+	writeJSON(w, http.StatusOK, responses.KubernetesClusterResponse{Data: *c})
+}
+
+// listClusterEvents serves both the zone-wide and the per-cluster event list.
+func (s *Server) listClusterEvents(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	clusterID := r.PathValue("clusterId")
+	res := responses.KubernetesEventsListResponse{Data: []responses.KubernetesEvent{}}
+	for i := len(s.state.clusterEvents) - 1; i >= 0; i-- {
+		e := s.state.clusterEvents[i]
+		if e.zoneID == zoneID && (clusterID == "" || e.clusterID == clusterID) {
+			res.Data = append(res.Data, e.KubernetesEvent)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
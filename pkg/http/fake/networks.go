@@ -0,0 +1,473 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Network and firewall rule statuses.
+const (
+	networkCreating    = "Creating"
+	networkAllocated   = "Allocated"
+	networkImplemented = "Implemented"
+	networkDestroying  = "Destroying"
+
+	firewallRuleActive = "Active"
+)
+
+func (s *Server) networkRoutes() {
+	s.handle(http.MethodGet, urls.NetworkServiceOfferingList, s.listNetworkOfferings, "zoneId")
+	s.handle(http.MethodPost, urls.NetworkCreateL2, s.createL2Network, "zoneId")
+	s.handle(http.MethodPost, urls.NetworkCreateL3, s.createL3Network, "zoneId")
+	s.handle(http.MethodGet, urls.NetworkList, s.listNetworks, "zoneId")
+	s.handle(http.MethodGet, urls.NetworkShow, s.showNetwork, "zoneId", "networkId")
+	s.handle(http.MethodDelete, urls.NetworkDelete, s.deleteNetwork, "zoneId", "networkId")
+	s.handle(http.MethodPost, urls.NetworkInstanceConnect, s.connectInstance, "zoneId", "networkId")
+	s.handle(http.MethodPost, urls.NetworkInstanceDisconnect, s.disconnectInstance, "zoneId", "networkId")
+	s.handle(http.MethodGet, urls.NetworkInstanceList, s.listNetworkInstances, "zoneId", "networkId")
+
+	s.handle(http.MethodGet, urls.NetworkFirewallIPv4List, s.listIPv4Rules, "zoneId", "networkId")
+	s.handle(http.MethodPost, urls.NetworkFirewallIPv4Create, s.createIPv4Rule, "zoneId", "networkId")
+	s.handle(http.MethodDelete, urls.NetworkFirewallIPv4Delete, s.deleteIPv4Rule, "zoneId", "networkId", "ruleId")
+	s.handle(http.MethodGet, urls.NetworkFirewallIPv6List, s.listIPv6Rules, "zoneId", "networkId")
+	s.handle(http.MethodPost, urls.NetworkFirewallIPv6Create, s.createIPv6Rule, "zoneId", "networkId")
+	s.handle(http.MethodDelete, urls.NetworkFirewallIPv6Delete, s.deleteIPv6Rule, "zoneId", "networkId", "ruleId")
+}
+
+func (s *Server) listNetworkOfferings(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NetworkServiceOfferingListResponse{Data: s.state.NetworkOfferings})
+}
+
+func (s *Server) findNetwork(zoneID, id string) *network {
+	for _, n := range s.state.networks {
+		if n.zoneID == zoneID && n.ID == id {
+			return n
+		}
+	}
+	return nil
+}
+
+// network returns the network named by the path, writing a 404 when it does
+// not exist.
+func (s *Server) network(w http.ResponseWriter, r *http.Request) (*network, bool) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return nil, false
+	}
+	if n := s.findNetwork(zoneID, r.PathValue("networkId")); n != nil {
+		return n, true
+	}
+	writeNotFound(w, "Network")
+	return nil, false
+}
+
+type networkRequest struct {
+	NetworkOfferingID string `json:"network_offering_id"`
+	Name              string `json:"name"`
+	Gateway           string `json:"gateway"`
+	Netmask           string `json:"netmask"`
+}
+
+func (s *Server) createL2Network(w http.ResponseWriter, r *http.Request) {
+	s.createNetwork(w, r, "L2", networkAllocated)
+}
+
+func (s *Server) createL3Network(w http.ResponseWriter, r *http.Request) {
+	s.createNetwork(w, r, "Isolated", networkImplemented)
+}
+
+func (s *Server) createNetwork(w http.ResponseWriter, r *http.Request, offeringType, final string) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	var req networkRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	errs.require("network_offering_id", req.NetworkOfferingID)
+	var offering *responses.NetworkOffering
+	for i := range s.state.NetworkOfferings {
+		if o := s.state.NetworkOfferings[i]; o.ID == req.NetworkOfferingID && o.Type == offeringType {
+			offering = &o
+		}
+	}
+	if req.NetworkOfferingID != "" && offering == nil {
+		errs.add("network_offering_id", fmt.Sprintf("The selected network offering id is not a valid %s offering.", offeringType))
+	}
+	if offeringType == "Isolated" {
+		errs.require("gateway", req.Gateway)
+		errs.require("netmask", req.Netmask)
+	}
+	if slices.ContainsFunc(s.state.networks, func(n *network) bool { return n.zoneID == zoneID && n.Name == req.Name }) {
+		errs.add("name", "The name has already been taken.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	n := &network{
+		Network: responses.Network{
+			ID:              newID(),
+			Name:            req.Name,
+			Status:          networkCreating,
+			NetworkOffering: *offering,
+			InstanceNetwork: []responses.InstanceNetwork{},
+		},
+		zoneID: zoneID,
+		ipv4:   []responses.IPv4FirewallRule{},
+		ipv6:   []responses.IPv6FirewallRule{},
+	}
+	if offeringType == "Isolated" {
+		n.gateway, n.netmask = req.Gateway, req.Netmask
+	}
+	s.state.networks = append(s.state.networks, n)
+	s.later(func() { n.Status = final })
+
+	writeSuccess(w)
+}
+
+func (s *Server) listNetworks(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+	res := responses.NetworkListResponse{Data: []responses.Network{}}
+	for _, n := range s.state.networks {
+		if n.zoneID == zoneID {
+			res.Data = append(res.Data, n.Network)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) showNetwork(w http.ResponseWriter, r *http.Request) {
+	if n, ok := s.network(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.NetworkShowResponse{Data: n.Network})
+	}
+}
+
+func (s *Server) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.network(w, r)
+	if !ok {
+		return
+	}
+	if len(n.InstanceNetwork) > 0 {
+		writeError(w, http.StatusConflict, "The network still has connected instances.")
+		return
+	}
+	n.Status = networkDestroying
+	s.later(func() {
+		s.state.networks = slices.DeleteFunc(s.state.networks, func(other *network) bool { return other == n })
+	})
+	writeSuccess(w)
+}
+
+// attach connects inst to n and assigns it an address from the network's range.
+func (s *Server) attach(n *network, inst *responses.Instance, isDefault bool) {
+	var ip string
+	if n.gateway != "" {
+		prefix := n.gateway[:strings.LastIndex(n.gateway, ".")+1]
+		ip = prefix + strconv.Itoa(len(n.InstanceNetwork)+10)
+	}
+	offering := n.NetworkOffering
+	in := responses.InstanceNetwork{
+		ID:           newID(),
+		InstanceID:   inst.ID,
+		InstanceName: inst.Name,
+		IPAddress:    ip,
+		MACAddress:   fmt.Sprintf("02:00:00:%02x:%02x:%02x", len(s.state.instances), len(s.state.networks), len(n.InstanceNetwork)),
+		IsDefault:    isDefault,
+		CreatedAt:    time.Now().Unix(),
+		Network: responses.NetworkSummary{
+			ID:       n.ID,
+			Name:     n.Name,
+			IPConfig: responses.IPConfigOrArray{Gateway: n.gateway, Netmask: n.netmask},
+		},
+		NetworkOffering: responses.NetworkOfferingSummary{
+			ID:                     offering.ID,
+			Name:                   offering.Name,
+			DisplayName:            offering.DisplayName,
+			DisplayNameFA:          offering.DisplayNameFA,
+			HourlyStartedPrice:     offering.HourlyStartedPrice,
+			TrafficTransferFreeGig: offering.TrafficTransferPlan,
+			NetworkRate:            offering.NetworkRate,
+			Type:                   offering.Type,
+			Description:            offering.Description,
+			InternetProtocol:       offering.InternetProtocol,
+		},
+		SecondaryIPs: []responses.SecondaryIP{},
+	}
+	n.InstanceNetwork = append(n.InstanceNetwork, in)
+}
+
+// detach removes every connection of the instance from the network.
+func (n *network) detach(instanceID string) {
+	n.InstanceNetwork = slices.DeleteFunc(n.InstanceNetwork, func(in responses.InstanceNetwork) bool {
+		return in.InstanceID == instanceID
+	})
+}
+
+func (s *Server) connectInstance(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.network(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("instance_id", req.InstanceID)
+	i := slices.IndexFunc(s.state.instances, func(inst *responses.Instance) bool {
+		return inst.ZoneID == n.zoneID && inst.ID == req.InstanceID
+	})
+	if req.InstanceID != "" && i < 0 {
+		errs.add("instance_id", "The selected instance id is invalid.")
+	}
+	if errs.write(w) {
+		return
+	}
+	if slices.ContainsFunc(n.InstanceNetwork, func(in responses.InstanceNetwork) bool { return in.InstanceID == req.InstanceID }) {
+		writeError(w, http.StatusConflict, "The instance is already connected to this network.")
+		return
+	}
+
+	s.attach(n, s.state.instances[i], false)
+	writeSuccess(w)
+}
+
+func (s *Server) disconnectInstance(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.network(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		InstanceID        string `json:"instance_id"`
+		InstanceNetworkID string `json:"instance_network_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := fieldErrors{}
+	errs.require("instance_id", req.InstanceID)
+	errs.require("instance_network_id", req.InstanceNetworkID)
+	if errs.write(w) {
+		return
+	}
+	i := slices.IndexFunc(n.InstanceNetwork, func(in responses.InstanceNetwork) bool {
+		return in.ID == req.InstanceNetworkID && in.InstanceID == req.InstanceID
+	})
+	if i < 0 {
+		writeNotFound(w, "Instance network")
+		return
+	}
+
+	n.InstanceNetwork = slices.Delete(n.InstanceNetwork, i, i+1)
+	writeSuccess(w)
+}
+
+func (s *Server) listNetworkInstances(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.network(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	res := responses.InstanceNetworkListResponse{Data: []responses.InstanceNetwork{}}
+	for _, in := range n.InstanceNetwork {
+		if req.InstanceID == "" || in.InstanceID == req.InstanceID {
+			res.Data = append(res.Data, in)
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// firewallRequest is the body of both firewall create endpoints.
+type firewallRequest struct {
+	TrafficType   string `json:"traffic_type"`
+	ProtocolType  string `json:"protocol_type"`
+	PublicIPID    string `json:"public_ip_id"`
+	IPSource      string `json:"ip_source"`
+	IPDestination string `json:"ip_destination"`
+	PortStart     *int   `json:"port_start"`
+	PortEnd       *int   `json:"port_end"`
+	ICMPCode      *int   `json:"icmp_code"`
+	ICMPType      *int   `json:"icmp_type"`
+}
+
+func (req firewallRequest) validate() fieldErrors {
+	errs := fieldErrors{}
+	errs.require("traffic_type", req.TrafficType)
+	errs.require("protocol_type", req.ProtocolType)
+	errs.require("ip_source", req.IPSource)
+	errs.require("ip_destination", req.IPDestination)
+	if req.TrafficType != "" && req.TrafficType != "Ingress" && req.TrafficType != "Egress" {
+		errs.add("traffic_type", "The selected traffic type is invalid.")
+	}
+	switch req.ProtocolType {
+	case "", "TCP", "UDP":
+	case "ICMP":
+		if req.ICMPCode == nil {
+			errs.add("icmp_code", "The icmp code field is required when protocol type is ICMP.")
+		}
+		if req.ICMPType == nil {
+			errs.add("icmp_type", "The icmp type field is required when protocol type is ICMP.")
+		}
+	default:
+		errs.add("protocol_type", "The selected protocol type is invalid.")
+	}
+	if req.PortStart != nil && req.PortEnd != nil && *req.PortStart > *req.PortEnd {
+		errs.add("port_end", "The port end must be greater than or equal to port start.")
+	}
+	return errs
+}
+
+// firewallNetwork returns the network for a firewall endpoint. Firewall rules
+// are only available on isolated networks.
+func (s *Server) firewallNetwork(w http.ResponseWriter, r *http.Request) (*network, bool) {
+	n, ok := s.network(w, r)
+	if !ok {
+		return nil, false
+	}
+	if n.NetworkOffering.Type != "Isolated" {
+		writeError(w, http.StatusUnprocessableEntity, "Firewall rules are only available on isolated (L3) networks.")
+		return nil, false
+	}
+	return n, true
+}
+
+func portString(port *int) *string {
+	if port == nil || *port == 0 {
+		return nil
+	}
+	s := strconv.Itoa(*port)
+	return &s
+}
+
+func (s *Server) listIPv4Rules(w http.ResponseWriter, r *http.Request) {
+	if n, ok := s.firewallNetwork(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.IPv4FirewallRuleListResponse{Data: n.ipv4})
+	}
+}
+
+func (s *Server) createIPv4Rule(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.firewallNetwork(w, r)
+	if !ok {
+		return
+	}
+	var req firewallRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	errs := req.validate()
+	if req.TrafficType == "Ingress" {
+		errs.require("public_ip_id", req.PublicIPID)
+	}
+	if errs.write(w) {
+		return
+	}
+
+	rule := responses.IPv4FirewallRule{
+		ID:            newID(),
+		Protocol:      req.ProtocolType,
+		TrafficType:   req.TrafficType,
+		IPSource:      req.IPSource,
+		IPDestination: req.IPDestination,
+		PortStart:     portString(req.PortStart),
+		PortEnd:       portString(req.PortEnd),
+		ICMPCode:      req.ICMPCode,
+		ICMPType:      req.ICMPType,
+		Status:        firewallRuleActive,
+		CreatedAt:     time.Now().Unix(),
+	}
+	if req.PublicIPID != "" {
+		rule.NetworkPublicIPID = &req.PublicIPID
+	}
+	n.ipv4 = append(n.ipv4, rule)
+	writeSuccess(w)
+}
+
+func (s *Server) deleteIPv4Rule(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.firewallNetwork(w, r)
+	if !ok {
+		return
+	}
+	id := r.PathValue("ruleId")
+	i := slices.IndexFunc(n.ipv4, func(rule responses.IPv4FirewallRule) bool { return rule.ID == id })
+	if i < 0 {
+		writeNotFound(w, "Firewall rule")
+		return
+	}
+	n.ipv4 = slices.Delete(n.ipv4, i, i+1)
+	writeSuccess(w)
+}
+
+func (s *Server) listIPv6Rules(w http.ResponseWriter, r *http.Request) {
+	if n, ok := s.firewallNetwork(w, r); ok {
+		writeJSON(w, http.StatusOK, responses.IPv6FirewallRuleListResponse{Data: n.ipv6})
+	}
+}
+
+func (s *Server) createIPv6Rule(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.firewallNetwork(w, r)
+	if !ok {
+		return
+	}
+	var req firewallRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.validate().write(w) {
+		return
+	}
+
+	n.ipv6 = append(n.ipv6, responses.IPv6FirewallRule{
+		ID:            newID(),
+		Protocol:      req.ProtocolType,
+		TrafficType:   req.TrafficType,
+		IPSource:      req.IPSource,
+		IPDestination: req.IPDestination,
+		PortStart:     portString(req.PortStart),
+		PortEnd:       portString(req.PortEnd),
+		ICMPCode:      req.ICMPCode,
+		ICMPType:      req.ICMPType,
+		Status:        firewallRuleActive,
+		CreatedAt:     time.Now().Unix(),
+	})
+	writeSuccess(w)
+}
+
+func (s *Server) deleteIPv6Rule(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.firewallNetwork(w, r)
+	if !ok {
+		return
+	}
+	id := r.PathValue("ruleId")
+	i := slices.IndexFunc(n.ipv6, func(rule responses.IPv6FirewallRule) bool { return rule.ID == id })
+	if i < 0 {
+		writeNotFound(w, "Firewall rule")
+		return
+	}
+	n.ipv6 = slices.Delete(n.ipv6, i, i+1)
+	writeSuccess(w)
+}
@@ -0,0 +1,302 @@
+// Package fake implements an in-memory stand-in for the Virak Cloud public API.
+//
+// The server answers the routes listed in pkg/urls.go with the same payloads
+// the real API returns, so the CLI and pkg/http can be exercised offline:
+//
+//	srv := httptest.NewServer(fake.NewServer())
+//	defer srv.Close()
+//	client := http.NewClient("any-token", http.WithBaseURL(srv.URL))
+//
+// State lives in memory and is lost when the server stops. Asynchronous
+// operations (creating an instance, stopping a cluster, ...) settle after the
+// configured provisioning delay, which makes it possible to demo --wait.
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// Server is an http.Handler serving the fake API. The zero value is not usable;
+// create servers with NewServer.
+type Server struct {
+	mu      sync.Mutex
+	mux     *http.ServeMux
+	token   string
+	revoked map[string]bool
+	delay   time.Duration
+	pending []transition
+	state   *state
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithToken makes the server reject every bearer token except token. By
+// default any non-empty token is accepted.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithProvisionDelay sets how long asynchronous operations take to reach their
+// final state. Zero (the default) applies them immediately.
+func WithProvisionDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.delay = delay
+	}
+}
+
+// WithAbilities replaces the abilities of the token, which are "*" by default.
+// Requests the abilities do not allow are answered with 403 Forbidden.
+func WithAbilities(abilities []string) Option {
+	return func(s *Server) {
+		s.state.Abilities = abilities
+	}
+}
+
+// NewServer returns a fake API server seeded with zones, offerings, images and
+// a user account.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		mux:     http.NewServeMux(),
+		revoked: map[string]bool{},
+		state:   newState(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes()
+	return s
+}
+
+// ServeHTTP authenticates the request and dispatches it. Requests are served
+// one at a time, which keeps the in-memory state consistent.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" || (s.token != "" && token != s.token) {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.revoked[token] {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated.")
+		return
+	}
+	s.settle()
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers h for method and the URL format of pkg/urls.go. The leading
+// %s (the base URL) is dropped and every other %s becomes the next wildcard in
+// names, e.g. handle("GET", urls.InstanceShow, h, "zoneId", "instanceId").
+func (s *Server) handle(method, format string, h http.HandlerFunc, names ...string) {
+	path := strings.TrimPrefix(format, "%s")
+	for _, name := range names {
+		path = strings.Replace(path, "%s", "{"+name+"}", 1)
+	}
+	if strings.Contains(path, "%s") {
+		panic(fmt.Sprintf("fake: missing wildcard names for %q", format))
+	}
+	ability := abilityFor(method, path)
+	s.mux.HandleFunc(method+" "+path, func(w http.ResponseWriter, r *http.Request) {
+		if ability != "" && !s.allowed(ability) {
+			writeError(w, http.StatusForbidden, "This action is unauthorized.")
+			return
+		}
+		h(w, r)
+	})
+}
+
+// abilityFor returns the token ability a route needs, e.g. instance:write for
+// POST /zone/{zoneId}/instance. Token introspection needs none.
+func abilityFor(method, path string) string {
+	var resource string
+	switch parts := strings.Split(strings.Trim(path, "/"), "/"); {
+	case parts[0] == "zones" || (parts[0] == "zone" && len(parts) <= 3):
+		resource = "zone"
+	case parts[0] == "zone":
+		resource = map[string]string{"object-storage": "bucket"}[parts[2]]
+		if resource == "" {
+			resource = parts[2]
+		}
+	case parts[0] == "dns":
+		resource = "dns"
+	case parts[0] == "user" && len(parts) > 1 && strings.HasPrefix(parts[1], "token"):
+		return ""
+	case parts[0] == "user" && len(parts) > 1 && parts[1] == "finance":
+		resource = "finance"
+	default:
+		resource = parts[0]
+	}
+	if method == http.MethodGet {
+		return resource + ":read"
+	}
+	return resource + ":write"
+}
+
+// allowed reports whether the token's abilities include ability. It must be
+// called with s.mu held.
+func (s *Server) allowed(ability string) bool {
+	resource, _, _ := strings.Cut(ability, ":")
+	for _, granted := range s.state.Abilities {
+		if granted == "*" || granted == ability || granted == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) routes() {
+	s.zoneRoutes()
+	s.instanceRoutes()
+	s.networkRoutes()
+	s.dnsRoutes()
+	s.bucketRoutes()
+	s.kubernetesRoutes()
+	s.userRoutes()
+	s.financeRoutes()
+
+	// Everything else, e.g. load balancers and volumes, is not simulated.
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented, fmt.Sprintf("%s %s is not implemented by the fake server", r.Method, r.URL.Path))
+	})
+}
+
+// transition is a state change that becomes visible once its time has come.
+type transition struct {
+	at    time.Time
+	apply func()
+}
+
+// later schedules apply after the provisioning delay. It must be called with
+// s.mu held; apply runs with s.mu held as well.
+func (s *Server) later(apply func()) {
+	if s.delay <= 0 {
+		apply()
+		return
+	}
+	s.pending = append(s.pending, transition{at: time.Now().Add(s.delay), apply: apply})
+}
+
+// settle applies the transitions that are due.
+func (s *Server) settle() {
+	now := time.Now()
+	var remaining []transition
+	for _, t := range s.pending {
+		if now.Before(t.at) {
+			remaining = append(remaining, t)
+			continue
+		}
+		t.apply()
+	}
+	s.pending = remaining
+}
+
+func newID() string {
+	return ulid.Make().String()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, responses.ErrorResponse{Message: message})
+}
+
+func writeNotFound(w http.ResponseWriter, what string) {
+	writeError(w, http.StatusNotFound, what+" not found.")
+}
+
+func writeSuccess(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, responses.SuccessResponse{Data: struct {
+		Success bool `json:"success"`
+	}{Success: true}})
+}
+
+func writeMessage(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, responses.DnsMessage{Message: message})
+}
+
+// decode reads the JSON request body into v. An empty body leaves v untouched.
+// It writes a 400 response and returns false when the body is malformed.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Body == nil {
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "Malformed JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// fieldErrors collects validation failures in the API's 422 format.
+type fieldErrors map[string][]string
+
+func (e fieldErrors) add(field, message string) {
+	e[field] = append(e[field], message)
+}
+
+func (e fieldErrors) require(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		e.add(field, fmt.Sprintf("The %s field is required.", strings.ReplaceAll(field, "_", " ")))
+	}
+}
+
+// write sends the collected errors and reports whether there were any.
+func (e fieldErrors) write(w http.ResponseWriter) bool {
+	if len(e) == 0 {
+		return false
+	}
+	writeJSON(w, http.StatusUnprocessableEntity, responses.ErrorResponse{
+		Message: "The given data was invalid.",
+		Errors:  map[string][]string(e),
+	})
+	return true
+}
+
+// defaultPerPage is the page size used when the request does not set per_page.
+const defaultPerPage = 15
+
+// paginate returns the requested page of items along with its metadata.
+func paginate[T any](r *http.Request, items []T) ([]T, responses.Meta) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	page = max(page, 1)
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+
+	meta := responses.Meta{
+		CurrentPage: page,
+		PerPage:     perPage,
+		Total:       len(items),
+		LastPage:    max((len(items)+perPage-1)/perPage, 1),
+	}
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return []T{}, meta
+	}
+	end := min(start+perPage, len(items))
+	meta.From = start + 1
+	meta.To = end
+	return items[start:end], meta
+}
@@ -0,0 +1,124 @@
+package fake
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// DefaultZoneID is the ID of the first seeded zone.
+const DefaultZoneID = "01J9Y6ZQ5HZ0NE0000000000T1"
+
+//go:embed seed.json
+var seedJSON []byte
+
+// seed is the static catalogue every server starts with.
+type seed struct {
+	Zones               responses.DataCenter                  `json:"zones"`
+	ServiceOfferings    []responses.InstanceServiceOffering   `json:"service_offerings"`
+	VMImages            []responses.InstanceVMImage           `json:"vm_images"`
+	NetworkOfferings    []responses.NetworkOffering           `json:"network_offerings"`
+	KubernetesVersions  []responses.KubernetesVersion         `json:"kubernetes_versions"`
+	KubernetesOfferings []responses.KubernetesServiceOffering `json:"kubernetes_offerings"`
+	Profile             responses.UserProfileResponse         `json:"profile"`
+	Abilities           []string                              `json:"abilities"`
+	Wallet              responses.WalletsBalanceResponse      `json:"wallet"`
+	Payments            []interface{}                         `json:"payments"`
+	Documents           []responses.CostDocument              `json:"documents"`
+	SSHKeys             []responses.UserSSHKey                `json:"ssh_keys"`
+}
+
+// state is the mutable data of a Server. Slices keep insertion order so list
+// endpoints are deterministic.
+type state struct {
+	seed
+
+	instances     []*responses.Instance
+	networks      []*network
+	domains       []*domain
+	dnsEvents     []responses.DNSEvent
+	buckets       []*bucket
+	bucketEvents  []bucketEvent
+	clusters      []*responses.KubernetesCluster
+	clusterEvents []clusterEvent
+	expenses      []expense
+}
+
+type network struct {
+	responses.Network
+	zoneID  string
+	gateway string
+	netmask string
+	ipv4    []responses.IPv4FirewallRule
+	ipv6    []responses.IPv6FirewallRule
+}
+
+type domain struct {
+	responses.Domain
+	records []*responses.Record
+}
+
+type bucket struct {
+	responses.ObjectStorageBucket
+	zoneID string
+}
+
+type bucketEvent struct {
+	responses.ObjectStorageEvent
+	zoneID string
+}
+
+type clusterEvent struct {
+	responses.KubernetesEvent
+	zoneID    string
+	clusterID string
+}
+
+type expense struct {
+	responses.Expense
+	productType string
+	productID   string
+}
+
+func newState() *state {
+	st := &state{}
+	if err := json.Unmarshal(seedJSON, &st.seed); err != nil {
+		panic(fmt.Sprintf("fake: invalid seed data: %v", err))
+	}
+	return st
+}
+
+// zone returns the zone named by the zoneId path value, writing a 404 when it
+// does not exist.
+func (s *Server) zone(w http.ResponseWriter, r *http.Request) (string, bool) {
+	zoneID := r.PathValue("zoneId")
+	for _, z := range s.state.Zones.Data {
+		if z.ID == zoneID {
+			return zoneID, true
+		}
+	}
+	writeNotFound(w, "Zone")
+	return "", false
+}
+
+// charge records an expense for a billable product.
+func (s *Server) charge(productType, productID, description string, amount float64) {
+	now := time.Now()
+	s.state.expenses = append(s.state.expenses, expense{
+		Expense: responses.Expense{
+			ID:          newID(),
+			Date:        now.Format(time.DateOnly),
+			Type:        productType,
+			Description: description,
+			Amount:      amount,
+			Status:      "paid",
+			CreatedAt:   now.Format(time.RFC3339),
+		},
+		productType: productType,
+		productID:   productID,
+	})
+}
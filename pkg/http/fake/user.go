@@ -0,0 +1,86 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+func (s *Server) userRoutes() {
+	s.handle(http.MethodGet, urls.UserProfile, s.userProfile)
+	s.handle(http.MethodGet, urls.UserTokenAbilities, s.tokenAbilities)
+	s.handle(http.MethodGet, urls.UserTokenValidate, s.validateToken)
+	s.handle(http.MethodDelete, urls.UserTokenRevoke, s.revokeToken)
+	s.handle(http.MethodGet, urls.UserSSHKeyList, s.listSSHKeys)
+	s.handle(http.MethodPost, urls.UserSSHKeyCreate, s.createSSHKey)
+	s.handle(http.MethodDelete, urls.UserSSHKeyDelete, s.deleteSSHKey, "sshKeyId")
+}
+
+func (s *Server) userProfile(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.Profile)
+}
+
+func (s *Server) tokenAbilities(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, responses.UserTokenAbilitiesResponse{Abilities: s.state.Abilities})
+}
+
+// validateToken answers 204 because authentication already happened in
+// ServeHTTP.
+func (s *Server) validateToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// revokeToken rejects the request's token from now on.
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request) {
+	s.revoked[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")] = true
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listSSHKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, responses.UserSSHKeyListResponse{UserData: s.state.SSHKeys})
+}
+
+func (s *Server) createSSHKey(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name   string `json:"name"`
+		SSHKey string `json:"ssh_key"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	errs := fieldErrors{}
+	errs.require("name", req.Name)
+	errs.require("ssh_key", req.SSHKey)
+	keyType, _, _ := strings.Cut(req.SSHKey, " ")
+	if req.SSHKey != "" && !strings.HasPrefix(keyType, "ssh-") && !strings.HasPrefix(keyType, "ecdsa-") {
+		errs.add("ssh_key", "The ssh key must be a valid OpenSSH public key.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	s.state.SSHKeys = append(s.state.SSHKeys, responses.UserSSHKey{
+		ID:          newID(),
+		DisplayName: req.Name,
+		DataKey:     keyType,
+		DataValue:   req.SSHKey,
+		CreatedAt:   time.Now().Format(time.RFC3339),
+	})
+	writeSuccess(w)
+}
+
+func (s *Server) deleteSSHKey(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("sshKeyId")
+	i := slices.IndexFunc(s.state.SSHKeys, func(k responses.UserSSHKey) bool { return k.ID == id })
+	if i < 0 {
+		writeNotFound(w, "SSH key")
+		return
+	}
+	s.state.SSHKeys = slices.Delete(s.state.SSHKeys, i, i+1)
+	writeSuccess(w)
+}
@@ -0,0 +1,67 @@
+package fake
+
+import (
+	"net/http"
+
+	urls "github.com/virak-cloud/cli/pkg"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+func (s *Server) zoneRoutes() {
+	s.handle(http.MethodGet, urls.ZoneList, s.listZones)
+	s.handle(http.MethodGet, urls.ZoneActiveServicesList, s.zoneServices, "zoneId")
+	s.handle(http.MethodGet, urls.ZoneResourcesList, s.zoneResources, "zoneId")
+	// urls.ZoneNetworkList is the same route as urls.NetworkList and is served
+	// by the network handlers.
+}
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.Zones)
+}
+
+func (s *Server) zoneServices(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zone(w, r); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.ZoneActiveServicesResponse{
+		Instance:      true,
+		DataVolume:    true,
+		Network:       true,
+		ObjectStorage: true,
+		K8s:           true,
+	})
+}
+
+// Quotas reported by the resources endpoint.
+const (
+	quotaMemoryMB = 65536
+	quotaCPU      = 32
+	quotaVolumeGB = 2048
+	quotaVMs      = 20
+)
+
+func (s *Server) zoneResources(w http.ResponseWriter, r *http.Request) {
+	zoneID, ok := s.zone(w, r)
+	if !ok {
+		return
+	}
+
+	var res responses.CustomerResourceResponse
+	collected := &res.InstanceResourceCollected
+	collected.Memory.Total = quotaMemoryMB
+	collected.CPUNumber.Total = quotaCPU
+	collected.DataVolume.Total = quotaVolumeGB
+	collected.VMLimit.Total = quotaVMs
+	for _, inst := range s.state.instances {
+		if inst.ZoneID != zoneID {
+			continue
+		}
+		collected.VMLimit.Collected++
+		if inst.ServiceOffering != nil && inst.ServiceOffering.Hardware != nil {
+			collected.Memory.Collected += inst.ServiceOffering.Hardware.MemoryMB
+			collected.CPUNumber.Collected += inst.ServiceOffering.Hardware.CPUCore
+			collected.DataVolume.Collected += inst.ServiceOffering.Hardware.RootDiskSizeGB
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
@@ -2,20 +2,22 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	urls "github.com/virak-cloud/cli/pkg"
 	"github.com/virak-cloud/cli/pkg/http/responses"
+	"iter"
 	"net/http"
 )
 
 // Finance-related API methods for the Client
 
 // GetWallet fetches the user's wallet balance.
-func (client *Client) GetWallet() (*responses.WalletsBalanceResponse, error) {
+func (client *Client) GetWallet(ctx context.Context) (*responses.WalletsBalanceResponse, error) {
 	var result responses.WalletsBalanceResponse
-	url := fmt.Sprintf(urls.UserBalance, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserBalance, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -23,17 +25,14 @@ func (client *Client) GetWallet() (*responses.WalletsBalanceResponse, error) {
 }
 
 // ListDocuments fetches cost documents for a specific year.
-func (client *Client) ListDocuments(year int) (*responses.CostDocumentsYearlyResponse, error) {
+func (client *Client) ListDocuments(ctx context.Context, year int) (*responses.CostDocumentsYearlyResponse, error) {
 	var result responses.CostDocumentsYearlyResponse
-	// DEBUG: Log the current approach
-	fmt.Printf("DEBUG: Current approach - Using POST method with year=%d\n", year)
 	body, err := json.Marshal(map[string]int{"year": year})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal year: %w", err)
 	}
-	url := fmt.Sprintf(urls.UserCostDocumentList, urls.BaseUrl)
-	fmt.Printf("DEBUG: URL: %s\n", url)
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	url := fmt.Sprintf(urls.UserCostDocumentList, client.BaseURL)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -41,11 +40,11 @@ func (client *Client) ListDocuments(year int) (*responses.CostDocumentsYearlyRes
 }
 
 // ListDocumentsGET fetches cost documents for a specific year using GET method.
-func (client *Client) ListDocumentsGET(year int) (*responses.CostDocumentsYearlyResponse, error) {
+func (client *Client) ListDocumentsGET(ctx context.Context, year int) (*responses.CostDocumentsYearlyResponse, error) {
 	var result responses.CostDocumentsYearlyResponse
-	
-	url := fmt.Sprintf("%s/user/finance/documents?year=%d", urls.BaseUrl, year)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+
+	url := fmt.Sprintf("%s/user/finance/documents?year=%d", client.BaseURL, year)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +52,10 @@ func (client *Client) ListDocumentsGET(year int) (*responses.CostDocumentsYearly
 }
 
 // ListPayments fetches the user's payment history.
-func (client *Client) ListPayments() (*responses.PaymentListResponse, error) {
+func (client *Client) ListPayments(ctx context.Context) (*responses.PaymentListResponse, error) {
 	var result responses.PaymentListResponse
-	url := fmt.Sprintf(urls.UserPaymentList, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserPaymentList, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -64,15 +63,12 @@ func (client *Client) ListPayments() (*responses.PaymentListResponse, error) {
 }
 
 // ListExpenses fetches the user's expenses with optional filtering.
-func (client *Client) ListExpenses(filters map[string]string) (*responses.ExpensesListResponse, error) {
+func (client *Client) ListExpenses(ctx context.Context, filters map[string]string) (*responses.ExpensesListResponse, error) {
 	var result responses.ExpensesListResponse
-	
-	// DEBUG: Log the current approach
-	fmt.Printf("DEBUG: Current approach - Using GET method with filters=%+v\n", filters)
-	
+
 	// Build query string from filters
-	url := fmt.Sprintf("%s/user/finance/expenses", urls.BaseUrl)
-	
+	url := fmt.Sprintf("%s/user/finance/expenses", client.BaseURL)
+
 	// Add filters as query parameters if any
 	if len(filters) > 0 {
 		queryParams := ""
@@ -86,9 +82,8 @@ func (client *Client) ListExpenses(filters map[string]string) (*responses.Expens
 		}
 		url += queryParams
 	}
-	
-	fmt.Printf("DEBUG: URL: %s\n", url)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -96,26 +91,34 @@ func (client *Client) ListExpenses(filters map[string]string) (*responses.Expens
 }
 
 // ListExpensesWithRequiredParams fetches the user's expenses with required parameters.
-func (client *Client) ListExpensesWithRequiredParams(productType, productID string, filters map[string]string) (*responses.ExpensesListResponse, error) {
+func (client *Client) ListExpensesWithRequiredParams(ctx context.Context, productType, productID string, filters map[string]string, page PageOptions) (*responses.ExpensesListResponse, error) {
 	var result responses.ExpensesListResponse
-	
-	// DEBUG: Log the new approach with required params
-	fmt.Printf("DEBUG: New approach - Using GET method with product_type=%s, product_id=%s, filters=%+v\n", productType, productID, filters)
-	
+
 	// Build query string with required parameters
-	url := fmt.Sprintf("%s/user/finance/expenses?product_type=%s&product_id=%s", urls.BaseUrl, productType, productID)
-	
+	url := fmt.Sprintf("%s/user/finance/expenses?product_type=%s&product_id=%s", client.BaseURL, productType, productID)
+
 	// Add additional filters as query parameters if any
 	if len(filters) > 0 {
 		for key, value := range filters {
 			url += fmt.Sprintf("&%s=%s", key, value)
 		}
 	}
-	
-	fmt.Printf("DEBUG: URL: %s\n", url)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url = page.apply(url)
+
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
-}
\ No newline at end of file
+}
+
+// AllExpenses lazily iterates over the expenses of a product across all pages.
+func (client *Client) AllExpenses(ctx context.Context, productType, productID string, filters map[string]string, start PageOptions) iter.Seq2[responses.Expense, error] {
+	return Paginate(ctx, start, func(ctx context.Context, page PageOptions) ([]responses.Expense, responses.Meta, error) {
+		res, err := client.ListExpensesWithRequiredParams(ctx, productType, productID, filters, page)
+		if err != nil {
+			return nil, responses.Meta{}, err
+		}
+		return res.Data, res.Meta, nil
+	})
+}
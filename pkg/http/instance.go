@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	urls "github.com/virak-cloud/cli/pkg"
@@ -9,42 +10,42 @@ import (
 	"net/http"
 )
 
-func (client *Client) ListInstances(zoneId string) (*responses.InstanceListResponse, error) {
+func (client *Client) ListInstances(ctx context.Context, zoneId string) (*responses.InstanceListResponse, error) {
 	var result responses.InstanceListResponse
-	url := fmt.Sprintf(urls.InstanceList, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.InstanceList, client.BaseURL, zoneId)
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListInstanceServiceOfferings(zoneId string) (*responses.InstanceServiceOfferingListResponse, error) {
+func (client *Client) ListInstanceServiceOfferings(ctx context.Context, zoneId string) (*responses.InstanceServiceOfferingListResponse, error) {
 	var result responses.InstanceServiceOfferingListResponse
-	url := fmt.Sprintf(urls.InstanceServiceOfferingList, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.InstanceServiceOfferingList, client.BaseURL, zoneId)
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListInstanceVMImages(zoneId string) (*responses.InstanceVMImageListResponse, error) {
+func (client *Client) ListInstanceVMImages(ctx context.Context, zoneId string) (*responses.InstanceVMImageListResponse, error) {
 	var result responses.InstanceVMImageListResponse
-	url := fmt.Sprintf(urls.InstanceVMImageList, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.InstanceVMImageList, client.BaseURL, zoneId)
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) CreateInstance(zoneId, serviceOfferingId, vmImageId string, networkIds []string, name string) (*responses.InstanceCreateResponse, error) {
+func (client *Client) CreateInstance(ctx context.Context, zoneId, serviceOfferingId, vmImageId string, networkIds []string, name string) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceCreate, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.InstanceCreate, client.BaseURL, zoneId)
 	body, err := json.Marshal(map[string]interface{}{
 		"service_offering_id": serviceOfferingId,
 		"vm_image_id":         vmImageId,
@@ -54,75 +55,75 @@ func (client *Client) CreateInstance(zoneId, serviceOfferingId, vmImageId string
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) RebuildInstance(zoneId, instanceId, vmImageId string) (*responses.InstanceCreateResponse, error) {
+func (client *Client) RebuildInstance(ctx context.Context, zoneId, instanceId, vmImageId string) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceRebuild, urls.BaseUrl, zoneId, instanceId)
+	url := fmt.Sprintf(urls.InstanceRebuild, client.BaseURL, zoneId, instanceId)
 	body, err := json.Marshal(map[string]string{
 		"vm_image_id": vmImageId,
 	})
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) StartInstance(zoneId, instanceId string) (*responses.InstanceCreateResponse, error) {
+func (client *Client) StartInstance(ctx context.Context, zoneId, instanceId string) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceStart, urls.BaseUrl, zoneId, instanceId)
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceStart, client.BaseURL, zoneId, instanceId)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) StopInstance(zoneId, instanceId string, forced bool) (*responses.InstanceCreateResponse, error) {
+func (client *Client) StopInstance(ctx context.Context, zoneId, instanceId string, forced bool) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceStop, urls.BaseUrl, zoneId, instanceId)
+	url := fmt.Sprintf(urls.InstanceStop, client.BaseURL, zoneId, instanceId)
 	body, err := json.Marshal(map[string]bool{
 		"forced": forced,
 	})
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) RebootInstance(zoneId, instanceId string) (*responses.InstanceCreateResponse, error) {
+func (client *Client) RebootInstance(ctx context.Context, zoneId, instanceId string) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceReboot, urls.BaseUrl, zoneId, instanceId)
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceReboot, client.BaseURL, zoneId, instanceId)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DeleteInstance(zoneId, instanceId, name string) (*responses.InstanceCreateResponse, error) {
+func (client *Client) DeleteInstance(ctx context.Context, zoneId, instanceId, name string) (*responses.InstanceCreateResponse, error) {
 	var result responses.InstanceCreateResponse
-	url := fmt.Sprintf(urls.InstanceDelete, urls.BaseUrl, zoneId, instanceId)
+	url := fmt.Sprintf(urls.InstanceDelete, client.BaseURL, zoneId, instanceId)
 	body, err := json.Marshal(map[string]string{
 		"name": name,
 	})
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodDelete, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodDelete, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -130,9 +131,9 @@ func (client *Client) DeleteInstance(zoneId, instanceId, name string) (*response
 }
 
 // Instance Metrics
-func (client *Client) GetInstanceMetrics(zoneId, instanceId string, metrics []string, time int, aggregator string) (*responses.InstanceMetricsResponse, error) {
+func (client *Client) GetInstanceMetrics(ctx context.Context, zoneId, instanceId string, metrics []string, time int, aggregator string) (*responses.InstanceMetricsResponse, error) {
 	var result responses.InstanceMetricsResponse
-	url := fmt.Sprintf(urls.InstanceMetricsURL, urls.BaseUrl, zoneId, instanceId)
+	url := fmt.Sprintf(urls.InstanceMetricsURL, client.BaseURL, zoneId, instanceId)
 	body, err := json.Marshal(map[string]interface{}{
 		"metrics":    metrics,
 		"time":       time,
@@ -141,7 +142,7 @@ func (client *Client) GetInstanceMetrics(zoneId, instanceId string, metrics []st
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -149,9 +150,9 @@ func (client *Client) GetInstanceMetrics(zoneId, instanceId string, metrics []st
 }
 
 // Snapshot Create
-func (client *Client) CreateInstanceSnapshot(zoneId, instanceId, name string) (*responses.InstanceSnapshotCreateResponse, error) {
+func (client *Client) CreateInstanceSnapshot(ctx context.Context, zoneId, instanceId, name string) (*responses.InstanceSnapshotCreateResponse, error) {
 	var result responses.InstanceSnapshotCreateResponse
-	url := fmt.Sprintf(urls.InstanceSnapshotCreateURL, urls.BaseUrl, zoneId, instanceId)
+	url := fmt.Sprintf(urls.InstanceSnapshotCreateURL, client.BaseURL, zoneId, instanceId)
 	body, err := json.Marshal(map[string]string{
 		"instance_id": instanceId,
 		"name":        name,
@@ -159,7 +160,7 @@ func (client *Client) CreateInstanceSnapshot(zoneId, instanceId, name string) (*
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -167,10 +168,10 @@ func (client *Client) CreateInstanceSnapshot(zoneId, instanceId, name string) (*
 }
 
 // Snapshot Delete
-func (client *Client) DeleteInstanceSnapshot(zoneId, instanceId, snapshotId string) (*responses.InstanceSnapshotActionResponse, error) {
+func (client *Client) DeleteInstanceSnapshot(ctx context.Context, zoneId, instanceId, snapshotId string) (*responses.InstanceSnapshotActionResponse, error) {
 	var result responses.InstanceSnapshotActionResponse
-	url := fmt.Sprintf(urls.InstanceSnapshotDeleteURL, urls.BaseUrl, zoneId, instanceId, snapshotId)
-	err := client.handleRequest(http.MethodDelete, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceSnapshotDeleteURL, client.BaseURL, zoneId, instanceId, snapshotId)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -178,11 +179,11 @@ func (client *Client) DeleteInstanceSnapshot(zoneId, instanceId, snapshotId stri
 }
 
 // Snapshot Revert
-func (client *Client) RevertInstanceSnapshot(zoneId, instanceId, snapshotId string) (*responses.InstanceSnapshotActionResponse, error) {
+func (client *Client) RevertInstanceSnapshot(ctx context.Context, zoneId, instanceId, snapshotId string) (*responses.InstanceSnapshotActionResponse, error) {
 	var result responses.InstanceSnapshotActionResponse
-	url := fmt.Sprintf(urls.InstanceSnapshotRevertURL, urls.BaseUrl, zoneId, instanceId, snapshotId)
+	url := fmt.Sprintf(urls.InstanceSnapshotRevertURL, client.BaseURL, zoneId, instanceId, snapshotId)
 
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -190,10 +191,10 @@ func (client *Client) RevertInstanceSnapshot(zoneId, instanceId, snapshotId stri
 }
 
 // Volume Service Offering List
-func (client *Client) ListInstanceVolumeServiceOfferings(zoneId string) (*responses.InstanceVolumeServiceOfferingListResponse, error) {
+func (client *Client) ListInstanceVolumeServiceOfferings(ctx context.Context, zoneId string) (*responses.InstanceVolumeServiceOfferingListResponse, error) {
 	var result responses.InstanceVolumeServiceOfferingListResponse
-	url := fmt.Sprintf(urls.InstanceVolumeServiceOfferingListURL, urls.BaseUrl, zoneId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceVolumeServiceOfferingListURL, client.BaseURL, zoneId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -201,10 +202,10 @@ func (client *Client) ListInstanceVolumeServiceOfferings(zoneId string) (*respon
 }
 
 // Volume List
-func (client *Client) ListInstanceVolumes(zoneId string) (*responses.InstanceVolumeListResponse, error) {
+func (client *Client) ListInstanceVolumes(ctx context.Context, zoneId string) (*responses.InstanceVolumeListResponse, error) {
 	var result responses.InstanceVolumeListResponse
-	url := fmt.Sprintf(urls.InstanceVolumeListURL, urls.BaseUrl, zoneId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceVolumeListURL, client.BaseURL, zoneId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -212,9 +213,9 @@ func (client *Client) ListInstanceVolumes(zoneId string) (*responses.InstanceVol
 }
 
 // Volume Create
-func (client *Client) CreateInstanceVolume(zoneId, serviceOfferingId string, size int, name string) (*responses.InstanceVolumeCreateResponse, error) {
+func (client *Client) CreateInstanceVolume(ctx context.Context, zoneId, serviceOfferingId string, size int, name string) (*responses.InstanceVolumeCreateResponse, error) {
 	var result responses.InstanceVolumeCreateResponse
-	url := fmt.Sprintf(urls.InstanceVolumeCreateURL, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.InstanceVolumeCreateURL, client.BaseURL, zoneId)
 	body, err := json.Marshal(map[string]interface{}{
 		"service_offering_id": serviceOfferingId,
 		"size":                size,
@@ -223,7 +224,7 @@ func (client *Client) CreateInstanceVolume(zoneId, serviceOfferingId string, siz
 	if err != nil {
 		return nil, err
 	}
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -231,10 +232,10 @@ func (client *Client) CreateInstanceVolume(zoneId, serviceOfferingId string, siz
 }
 
 // Volume Delete
-func (client *Client) DeleteInstanceVolume(zoneId, volumeId string) (*responses.InstanceVolumeActionResponse, error) {
+func (client *Client) DeleteInstanceVolume(ctx context.Context, zoneId, volumeId string) (*responses.InstanceVolumeActionResponse, error) {
 	var result responses.InstanceVolumeActionResponse
-	url := fmt.Sprintf(urls.InstanceVolumeDeleteURL, urls.BaseUrl, zoneId, volumeId)
-	err := client.handleRequest(http.MethodDelete, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceVolumeDeleteURL, client.BaseURL, zoneId, volumeId)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -242,11 +243,11 @@ func (client *Client) DeleteInstanceVolume(zoneId, volumeId string) (*responses.
 }
 
 // Volume Detach
-func (client *Client) DetachInstanceVolume(zoneId, volumeId, instanceId string) (*responses.InstanceVolumeActionResponse, error) {
+func (client *Client) DetachInstanceVolume(ctx context.Context, zoneId, volumeId, instanceId string) (*responses.InstanceVolumeActionResponse, error) {
 	var result responses.InstanceVolumeActionResponse
-	url := fmt.Sprintf(urls.InstanceVolumeDetachURL, urls.BaseUrl, zoneId, volumeId, instanceId)
+	url := fmt.Sprintf(urls.InstanceVolumeDetachURL, client.BaseURL, zoneId, volumeId, instanceId)
 
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -254,10 +255,10 @@ func (client *Client) DetachInstanceVolume(zoneId, volumeId, instanceId string)
 }
 
 // Volume Attach
-func (client *Client) AttachInstanceVolume(zoneId, volumeId, instanceId string) (*responses.InstanceVolumeActionResponse, error) {
+func (client *Client) AttachInstanceVolume(ctx context.Context, zoneId, volumeId, instanceId string) (*responses.InstanceVolumeActionResponse, error) {
 	var result responses.InstanceVolumeActionResponse
-	url := fmt.Sprintf(urls.InstanceVolumeAttachURL, urls.BaseUrl, zoneId, volumeId, instanceId)
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceVolumeAttachURL, client.BaseURL, zoneId, volumeId, instanceId)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -265,10 +266,10 @@ func (client *Client) AttachInstanceVolume(zoneId, volumeId, instanceId string)
 }
 
 // Show Instance
-func (client *Client) ShowInstance(zoneId, instanceId string) (*responses.InstanceShowResponse, error) {
+func (client *Client) ShowInstance(ctx context.Context, zoneId, instanceId string) (*responses.InstanceShowResponse, error) {
 	var result responses.InstanceShowResponse
-	url := fmt.Sprintf(urls.InstanceShow, urls.BaseUrl, zoneId, instanceId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceShow, client.BaseURL, zoneId, instanceId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -276,10 +277,10 @@ func (client *Client) ShowInstance(zoneId, instanceId string) (*responses.Instan
 }
 
 // Get Instance Console
-func (client *Client) GetInstanceConsole(zoneId, instanceId string) (*responses.InstanceConsoleResponse, error) {
+func (client *Client) GetInstanceConsole(ctx context.Context, zoneId, instanceId string) (*responses.InstanceConsoleResponse, error) {
 	var result responses.InstanceConsoleResponse
-	url := fmt.Sprintf(urls.InstanceConsole, urls.BaseUrl, zoneId, instanceId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.InstanceConsole, client.BaseURL, zoneId, instanceId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,31 +11,31 @@ import (
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func (client *Client) GetKubernetesClusters(zoneID string) (*responses.KubernetesClusterListResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterList, urls.BaseUrl, zoneID)
+func (client *Client) GetKubernetesClusters(ctx context.Context, zoneID string) (*responses.KubernetesClusterListResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterList, client.BaseURL, zoneID)
 	var result responses.KubernetesClusterListResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetKubernetesCluster(zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
+func (client *Client) GetKubernetesCluster(ctx context.Context, zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
 
-	url := fmt.Sprintf(urls.KubernetesClusterShow, urls.BaseUrl, zoneID, clusterID)
+	url := fmt.Sprintf(urls.KubernetesClusterShow, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesClusterResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) CreateKubernetesCluster(zoneID, name, versionID, offeringID, sshKey, networkID string, ha bool, size int, description string, privateRegistryUsername, privateRegistryPassword, privateRegistryURL string, haControllerNodes int, haExternalLBIP string) (*responses.KubernetesMessage, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterCreate, urls.BaseUrl, zoneID)
+func (client *Client) CreateKubernetesCluster(ctx context.Context, zoneID, name, versionID, offeringID, sshKey, networkID string, ha bool, size int, description string, privateRegistryUsername, privateRegistryPassword, privateRegistryURL string, haControllerNodes int, haExternalLBIP string) (*responses.KubernetesMessage, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterCreate, client.BaseURL, zoneID)
 	var result responses.KubernetesMessage
 
 	body := map[string]interface{}{
@@ -71,15 +72,15 @@ func (client *Client) CreateKubernetesCluster(zoneID, name, versionID, offeringI
 		return nil, err
 	}
 
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) UpdateKubernetesClusterDetails(zoneID string, clusterID string, name string, description string) (*responses.KubernetesClusterResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterUpdate, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) UpdateKubernetesClusterDetails(ctx context.Context, zoneID string, clusterID string, name string, description string) (*responses.KubernetesClusterResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterUpdate, client.BaseURL, zoneID, clusterID)
 
 	var result responses.KubernetesClusterResponse
 
@@ -90,7 +91,7 @@ func (client *Client) UpdateKubernetesClusterDetails(zoneID string, clusterID st
 
 	jsonBody, _ := json.Marshal(body)
 
-	err := client.handleRequest(http.MethodPut, url, bytes.NewBuffer(jsonBody), &result)
+	err := client.handleRequest(ctx, http.MethodPut, url, bytes.NewBuffer(jsonBody), &result)
 	if err != nil {
 
 		return nil, err
@@ -99,41 +100,41 @@ func (client *Client) UpdateKubernetesClusterDetails(zoneID string, clusterID st
 
 }
 
-func (client *Client) DeleteKubernetesCluster(zoneID string, clusterID string) (*responses.KubernetesMessage, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterDelete, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) DeleteKubernetesCluster(ctx context.Context, zoneID string, clusterID string) (*responses.KubernetesMessage, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterDelete, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesMessage
 
-	err := client.handleRequest(http.MethodDelete, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) StartKubernetesCluster(zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterStart, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) StartKubernetesCluster(ctx context.Context, zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterStart, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesClusterResponse
 
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) StopKubernetesCluster(zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterStop, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) StopKubernetesCluster(ctx context.Context, zoneID string, clusterID string) (*responses.KubernetesClusterResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterStop, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesClusterResponse
 
-	err := client.handleRequest(http.MethodPost, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodPost, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ScaleKubernetesCluster(zoneID string, clusterID string, autoScaling bool, clusterSize int, minClusterSize int, maxClusterSize int) (*responses.KubernetesClusterResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterScale, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) ScaleKubernetesCluster(ctx context.Context, zoneID string, clusterID string, autoScaling bool, clusterSize int, minClusterSize int, maxClusterSize int) (*responses.KubernetesClusterResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterScale, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesClusterResponse
 
 	body := map[string]interface{}{
@@ -150,48 +151,48 @@ func (client *Client) ScaleKubernetesCluster(zoneID string, clusterID string, au
 		return nil, err
 	}
 
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (client *Client) GetKubernetesVersions(zoneID string) (*responses.KubernetesVersionsListResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesVersionsList, urls.BaseUrl, zoneID)
+func (client *Client) GetKubernetesVersions(ctx context.Context, zoneID string) (*responses.KubernetesVersionsListResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesVersionsList, client.BaseURL, zoneID)
 	var result responses.KubernetesVersionsListResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetKubernetesServiceEvents(zoneID string) (*responses.KubernetesEventsListResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesServiceEvents, urls.BaseUrl, zoneID)
+func (client *Client) GetKubernetesServiceEvents(ctx context.Context, zoneID string) (*responses.KubernetesEventsListResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesServiceEvents, client.BaseURL, zoneID)
 	var result responses.KubernetesEventsListResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (client *Client) GetKubernetesClusterEvents(zoneID string, clusterID string) (*responses.KubernetesEventsListResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesClusterEvents, urls.BaseUrl, zoneID, clusterID)
+func (client *Client) GetKubernetesClusterEvents(ctx context.Context, zoneID string, clusterID string) (*responses.KubernetesEventsListResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesClusterEvents, client.BaseURL, zoneID, clusterID)
 	var result responses.KubernetesEventsListResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (client *Client) GetKubernetesServiceOfferings(zoneID string) (*responses.KubernetesServiceOfferingsListResponse, error) {
-	url := fmt.Sprintf(urls.KubernetesServiceOfferingsList, urls.BaseUrl, zoneID)
+func (client *Client) GetKubernetesServiceOfferings(ctx context.Context, zoneID string) (*responses.KubernetesServiceOfferingsListResponse, error) {
+	url := fmt.Sprintf(urls.KubernetesServiceOfferingsList, client.BaseURL, zoneID)
 	var result responses.KubernetesServiceOfferingsListResponse
 
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
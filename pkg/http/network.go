@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,9 +11,9 @@ import (
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func (client *Client) CreateL3Network(zoneId, networkOfferingId, name, gateway, netmask string) (*responses.NetworkCreateResponse, error) {
+func (client *Client) CreateL3Network(ctx context.Context, zoneId, networkOfferingId, name, gateway, netmask string) (*responses.NetworkCreateResponse, error) {
 	var result responses.NetworkCreateResponse
-	url := fmt.Sprintf(urls.NetworkCreateL3, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.NetworkCreateL3, client.BaseURL, zoneId)
 	body, err := json.Marshal(map[string]string{
 		"network_offering_id": networkOfferingId,
 		"name":                name,
@@ -22,15 +23,15 @@ func (client *Client) CreateL3Network(zoneId, networkOfferingId, name, gateway,
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) CreateL2Network(zoneId, networkOfferingId, name string) (*responses.NetworkCreateResponse, error) {
+func (client *Client) CreateL2Network(ctx context.Context, zoneId, networkOfferingId, name string) (*responses.NetworkCreateResponse, error) {
 	var result responses.NetworkCreateResponse
-	url := fmt.Sprintf(urls.NetworkCreateL2, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.NetworkCreateL2, client.BaseURL, zoneId)
 	body, err := json.Marshal(map[string]string{
 		"network_offering_id": networkOfferingId,
 		"name":                name,
@@ -38,58 +39,58 @@ func (client *Client) CreateL2Network(zoneId, networkOfferingId, name string) (*
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListNetworks(zoneId string) (*responses.NetworkListResponse, error) {
+func (client *Client) ListNetworks(ctx context.Context, zoneId string) (*responses.NetworkListResponse, error) {
 	var result responses.NetworkListResponse
-	url := fmt.Sprintf(urls.NetworkList, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.NetworkList, client.BaseURL, zoneId)
 
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ShowNetwork(zoneId, networkId string) (*responses.NetworkShowResponse, error) {
+func (client *Client) ShowNetwork(ctx context.Context, zoneId, networkId string) (*responses.NetworkShowResponse, error) {
 	var result responses.NetworkShowResponse
-	url := fmt.Sprintf(urls.NetworkShow, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkShow, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DeleteNetwork(zoneId, networkId string) (*responses.NetworkDeleteResponse, error) {
+func (client *Client) DeleteNetwork(ctx context.Context, zoneId, networkId string) (*responses.NetworkDeleteResponse, error) {
 	var result responses.NetworkDeleteResponse
-	url := fmt.Sprintf(urls.NetworkDelete, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkDelete, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ConnectInstanceToNetwork(zoneId, networkId, instanceId string) (*responses.InstanceNetworkActionResponse, error) {
+func (client *Client) ConnectInstanceToNetwork(ctx context.Context, zoneId, networkId, instanceId string) (*responses.InstanceNetworkActionResponse, error) {
 	var result responses.InstanceNetworkActionResponse
-	url := fmt.Sprintf(urls.NetworkInstanceConnect, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkInstanceConnect, client.BaseURL, zoneId, networkId)
 	body, err := json.Marshal(map[string]string{
 		"instance_id": instanceId,
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DisconnectInstanceFromNetwork(zoneId, networkId, instanceId, instanceNetworkId string) (*responses.InstanceNetworkActionResponse, error) {
+func (client *Client) DisconnectInstanceFromNetwork(ctx context.Context, zoneId, networkId, instanceId, instanceNetworkId string) (*responses.InstanceNetworkActionResponse, error) {
 	var result responses.InstanceNetworkActionResponse
-	url := fmt.Sprintf(urls.NetworkInstanceDisconnect, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkInstanceDisconnect, client.BaseURL, zoneId, networkId)
 	body, err := json.Marshal(map[string]string{
 		"instance_id":         instanceId,
 		"instance_network_id": instanceNetworkId,
@@ -97,197 +98,197 @@ func (client *Client) DisconnectInstanceFromNetwork(zoneId, networkId, instanceI
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListNetworkInstances(zoneId, networkId string, instanceId string) (*responses.InstanceNetworkListResponse, error) {
+func (client *Client) ListNetworkInstances(ctx context.Context, zoneId, networkId string, instanceId string) (*responses.InstanceNetworkListResponse, error) {
 	var result responses.InstanceNetworkListResponse
-	url := fmt.Sprintf(urls.NetworkInstanceList, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkInstanceList, client.BaseURL, zoneId, networkId)
 	body, err := json.Marshal(map[string]string{
 		"instance_id": instanceId,
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodGet, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodGet, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // List IPv4 Firewall Rules
-func (client *Client) ListIPv4FirewallRules(zoneId, networkId string) (*responses.IPv4FirewallRuleListResponse, error) {
+func (client *Client) ListIPv4FirewallRules(ctx context.Context, zoneId, networkId string) (*responses.IPv4FirewallRuleListResponse, error) {
 	var result responses.IPv4FirewallRuleListResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv4List, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkFirewallIPv4List, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Create IPv4 Firewall Rule
-func (client *Client) CreateIPv4FirewallRule(zoneId, networkId string, body map[string]interface{}) (*responses.IPv4FirewallRuleActionResponse, error) {
+func (client *Client) CreateIPv4FirewallRule(ctx context.Context, zoneId, networkId string, body map[string]interface{}) (*responses.IPv4FirewallRuleActionResponse, error) {
 	var result responses.IPv4FirewallRuleActionResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv4Create, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkFirewallIPv4Create, client.BaseURL, zoneId, networkId)
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Delete IPv4 Firewall Rule
-func (client *Client) DeleteIPv4FirewallRule(zoneId, networkId, ruleId string) (*responses.IPv4FirewallRuleActionResponse, error) {
+func (client *Client) DeleteIPv4FirewallRule(ctx context.Context, zoneId, networkId, ruleId string) (*responses.IPv4FirewallRuleActionResponse, error) {
 	var result responses.IPv4FirewallRuleActionResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv4Delete, urls.BaseUrl, zoneId, networkId, ruleId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkFirewallIPv4Delete, client.BaseURL, zoneId, networkId, ruleId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // List IPv6 Firewall Rules
-func (client *Client) ListIPv6FirewallRules(zoneId, networkId string) (*responses.IPv6FirewallRuleListResponse, error) {
+func (client *Client) ListIPv6FirewallRules(ctx context.Context, zoneId, networkId string) (*responses.IPv6FirewallRuleListResponse, error) {
 	var result responses.IPv6FirewallRuleListResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv6List, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkFirewallIPv6List, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Create IPv6 Firewall Rule
-func (client *Client) CreateIPv6FirewallRule(zoneId, networkId string, body map[string]interface{}) (*responses.IPv6FirewallRuleActionResponse, error) {
+func (client *Client) CreateIPv6FirewallRule(ctx context.Context, zoneId, networkId string, body map[string]interface{}) (*responses.IPv6FirewallRuleActionResponse, error) {
 	var result responses.IPv6FirewallRuleActionResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv6Create, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkFirewallIPv6Create, client.BaseURL, zoneId, networkId)
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Delete IPv6 Firewall Rule
-func (client *Client) DeleteIPv6FirewallRule(zoneId, networkId, ruleId string) (*responses.IPv6FirewallRuleActionResponse, error) {
+func (client *Client) DeleteIPv6FirewallRule(ctx context.Context, zoneId, networkId, ruleId string) (*responses.IPv6FirewallRuleActionResponse, error) {
 	var result responses.IPv6FirewallRuleActionResponse
-	url := fmt.Sprintf(urls.NetworkFirewallIPv6Delete, urls.BaseUrl, zoneId, networkId, ruleId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkFirewallIPv6Delete, client.BaseURL, zoneId, networkId, ruleId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Public IP: List
-func (client *Client) ListNetworkPublicIps(zoneId, networkId string) (*responses.NetworkPublicIpListResponse, error) {
+func (client *Client) ListNetworkPublicIps(ctx context.Context, zoneId, networkId string) (*responses.NetworkPublicIpListResponse, error) {
 	var result responses.NetworkPublicIpListResponse
-	url := fmt.Sprintf(urls.NetworkPublicIpList, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkPublicIpList, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Public IP: Associate
-func (client *Client) AssociateNetworkPublicIp(zoneId, networkId string) (*responses.NetworkPublicIpActionResponse, error) {
+func (client *Client) AssociateNetworkPublicIp(ctx context.Context, zoneId, networkId string) (*responses.NetworkPublicIpActionResponse, error) {
 	var result responses.NetworkPublicIpActionResponse
-	url := fmt.Sprintf(urls.NetworkPublicIpAssociate, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodPost, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkPublicIpAssociate, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodPost, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Public IP: Disassociate
-func (client *Client) DisassociateNetworkPublicIp(zoneId, networkId, networkPublicIpId string) (*responses.NetworkPublicIpActionResponse, error) {
+func (client *Client) DisassociateNetworkPublicIp(ctx context.Context, zoneId, networkId, networkPublicIpId string) (*responses.NetworkPublicIpActionResponse, error) {
 	var result responses.NetworkPublicIpActionResponse
-	url := fmt.Sprintf(urls.NetworkPublicIpDisassociate, urls.BaseUrl, zoneId, networkId, networkPublicIpId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkPublicIpDisassociate, client.BaseURL, zoneId, networkId, networkPublicIpId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Public IP: Enable Static NAT
-func (client *Client) EnableNetworkPublicIpStaticNat(zoneId, networkId, networkPublicIpId, instanceId string) (*responses.NetworkPublicIpActionResponse, error) {
+func (client *Client) EnableNetworkPublicIpStaticNat(ctx context.Context, zoneId, networkId, networkPublicIpId, instanceId string) (*responses.NetworkPublicIpActionResponse, error) {
 	var result responses.NetworkPublicIpActionResponse
-	url := fmt.Sprintf(urls.NetworkPublicIpStaticNatEnable, urls.BaseUrl, zoneId, networkId, networkPublicIpId)
+	url := fmt.Sprintf(urls.NetworkPublicIpStaticNatEnable, client.BaseURL, zoneId, networkId, networkPublicIpId)
 	body, err := json.Marshal(map[string]string{"instance_id": instanceId})
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Public IP: Disable Static NAT
-func (client *Client) DisableNetworkPublicIpStaticNat(zoneId, networkId, networkPublicIpId string) (*responses.NetworkPublicIpActionResponse, error) {
+func (client *Client) DisableNetworkPublicIpStaticNat(ctx context.Context, zoneId, networkId, networkPublicIpId string) (*responses.NetworkPublicIpActionResponse, error) {
 	var result responses.NetworkPublicIpActionResponse
-	url := fmt.Sprintf(urls.NetworkPublicIpStaticNatDisable, urls.BaseUrl, zoneId, networkId, networkPublicIpId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkPublicIpStaticNatDisable, client.BaseURL, zoneId, networkId, networkPublicIpId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetNetworkVpnDetails(zoneId, networkId string) (*responses.NetworkVpnDetailResponse, error) {
+func (client *Client) GetNetworkVpnDetails(ctx context.Context, zoneId, networkId string) (*responses.NetworkVpnDetailResponse, error) {
 	var result responses.NetworkVpnDetailResponse
-	url := fmt.Sprintf(urls.NetworkVpnShowURL, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkVpnShowURL, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) EnableNetworkVpn(zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
+func (client *Client) EnableNetworkVpn(ctx context.Context, zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
 	var result responses.NetworkVpnSuccessResponse
-	url := fmt.Sprintf(urls.NetworkVpnEnableURL, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodPost, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkVpnEnableURL, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodPost, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DisableNetworkVpn(zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
+func (client *Client) DisableNetworkVpn(ctx context.Context, zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
 	var result responses.NetworkVpnSuccessResponse
-	url := fmt.Sprintf(urls.NetworkVpnDisableURL, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodPost, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkVpnDisableURL, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodPost, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) UpdateNetworkVpnCredentials(zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
+func (client *Client) UpdateNetworkVpnCredentials(ctx context.Context, zoneId, networkId string) (*responses.NetworkVpnSuccessResponse, error) {
 	var result responses.NetworkVpnSuccessResponse
-	url := fmt.Sprintf(urls.NetworkVpnUpdateURL, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodPut, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkVpnUpdateURL, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodPut, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListLoadBalancerRules(zoneId, networkId string) (*responses.LoadBalancerRuleListResponse, error) {
+func (client *Client) ListLoadBalancerRules(ctx context.Context, zoneId, networkId string) (*responses.LoadBalancerRuleListResponse, error) {
 	var result responses.LoadBalancerRuleListResponse
-	url := fmt.Sprintf(urls.NetworkLoadBalancerList, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkLoadBalancerList, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) CreateLoadBalancerRule(zoneId, networkId, publicIpId, name, algorithm string, publicPort, privatePort int) (*responses.SuccessResponse, error) {
+func (client *Client) CreateLoadBalancerRule(ctx context.Context, zoneId, networkId, publicIpId, name, algorithm string, publicPort, privatePort int) (*responses.SuccessResponse, error) {
 	var result responses.SuccessResponse
-	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleCreate, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleCreate, client.BaseURL, zoneId, networkId)
 	body, err := json.Marshal(map[string]interface{}{
 		"public_ip_id": publicIpId,
 		"name":         name,
@@ -298,80 +299,80 @@ func (client *Client) CreateLoadBalancerRule(zoneId, networkId, publicIpId, name
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DeleteLoadBalancerRule(zoneId, networkId, ruleId string) (*responses.SuccessResponse, error) {
+func (client *Client) DeleteLoadBalancerRule(ctx context.Context, zoneId, networkId, ruleId string) (*responses.SuccessResponse, error) {
 	var result responses.SuccessResponse
-	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleDelete, urls.BaseUrl, zoneId, networkId, ruleId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleDelete, client.BaseURL, zoneId, networkId, ruleId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) AssignLoadBalancerRule(zoneId, networkId, ruleId string, instanceNetworkIds []string) (*responses.SuccessResponse, error) {
+func (client *Client) AssignLoadBalancerRule(ctx context.Context, zoneId, networkId, ruleId string, instanceNetworkIds []string) (*responses.SuccessResponse, error) {
 	var result responses.SuccessResponse
-	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleAssign, urls.BaseUrl, zoneId, networkId, ruleId)
+	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleAssign, client.BaseURL, zoneId, networkId, ruleId)
 	body, err := json.Marshal(map[string]interface{}{
 		"instance_network_ids": instanceNetworkIds,
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) DeassignLoadBalancerRule(zoneId, networkId, ruleId, instanceNetworkId string) (*responses.SuccessResponse, error) {
+func (client *Client) DeassignLoadBalancerRule(ctx context.Context, zoneId, networkId, ruleId, instanceNetworkId string) (*responses.SuccessResponse, error) {
 	var result responses.SuccessResponse
-	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleDeassign, urls.BaseUrl, zoneId, networkId, ruleId)
+	url := fmt.Sprintf(urls.NetworkLoadBalancerRuleDeassign, client.BaseURL, zoneId, networkId, ruleId)
 	body, err := json.Marshal(map[string]interface{}{
 		"instance_network_id": instanceNetworkId,
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetHaproxyLive(zoneId, networkId string) (*responses.HaproxyLiveResponse, error) {
+func (client *Client) GetHaproxyLive(ctx context.Context, zoneId, networkId string) (*responses.HaproxyLiveResponse, error) {
 	var result responses.HaproxyLiveResponse
-	url := fmt.Sprintf(urls.NetworkHaproxyLive, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkHaproxyLive, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetHaproxyLog(zoneId, networkId string) (*responses.HaproxyLogResponse, error) {
+func (client *Client) GetHaproxyLog(ctx context.Context, zoneId, networkId string) (*responses.HaproxyLogResponse, error) {
 	var result responses.HaproxyLogResponse
-	url := fmt.Sprintf(urls.NetworkHaproxyLog, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkHaproxyLog, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) ListNetworkServiceOfferings(zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
+func (client *Client) ListNetworkServiceOfferings(ctx context.Context, zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
 	var result responses.NetworkServiceOfferingListResponse
-	url := fmt.Sprintf(urls.NetworkServiceOfferingList, urls.BaseUrl, zoneId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkServiceOfferingList, client.BaseURL, zoneId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetL2NetworkServiceOfferings(zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
-	allOfferings, err := client.ListNetworkServiceOfferings(zoneId)
+func (client *Client) GetL2NetworkServiceOfferings(ctx context.Context, zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
+	allOfferings, err := client.ListNetworkServiceOfferings(ctx, zoneId)
 	if err != nil {
 		return nil, err
 	}
@@ -388,8 +389,8 @@ func (client *Client) GetL2NetworkServiceOfferings(zoneId string) (*responses.Ne
 	}, nil
 }
 
-func (client *Client) GetL3NetworkServiceOfferings(zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
-	allOfferings, err := client.ListNetworkServiceOfferings(zoneId)
+func (client *Client) GetL3NetworkServiceOfferings(ctx context.Context, zoneId string) (*responses.NetworkServiceOfferingListResponse, error) {
+	allOfferings, err := client.ListNetworkServiceOfferings(ctx, zoneId)
 	if err != nil {
 		return nil, err
 	}
@@ -407,35 +408,35 @@ func (client *Client) GetL3NetworkServiceOfferings(zoneId string) (*responses.Ne
 }
 
 // List Port Forwarding Rules
-func (client *Client) ListPortForwards(zoneId, networkId string) (*responses.PortForwardListResponse, error) {
+func (client *Client) ListPortForwards(ctx context.Context, zoneId, networkId string) (*responses.PortForwardListResponse, error) {
 	var result responses.PortForwardListResponse
-	url := fmt.Sprintf(urls.NetworkPortForwardList, urls.BaseUrl, zoneId, networkId)
-	if err := client.handleRequest(http.MethodGet, url, nil, &result); err != nil {
+	url := fmt.Sprintf(urls.NetworkPortForwardList, client.BaseURL, zoneId, networkId)
+	if err := client.handleRequest(ctx, http.MethodGet, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Create Port Forwarding Rule
-func (client *Client) CreatePortForward(zoneId string, request map[string]interface{}) (*responses.PortForwardActionResponse, error) {
+func (client *Client) CreatePortForward(ctx context.Context, zoneId string, request map[string]interface{}) (*responses.PortForwardActionResponse, error) {
 	var result responses.PortForwardActionResponse
 	networkId := request["network_id"].(string)
-	url := fmt.Sprintf(urls.NetworkPortForwardCreate, urls.BaseUrl, zoneId, networkId)
+	url := fmt.Sprintf(urls.NetworkPortForwardCreate, client.BaseURL, zoneId, networkId)
 	jsonBody, err := json.Marshal(request)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.handleRequest(http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
+	if err := client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // Delete Port Forwarding Rule
-func (client *Client) DeletePortForward(zoneId, ruleId string) (*responses.PortForwardActionResponse, error) {
+func (client *Client) DeletePortForward(ctx context.Context, zoneId, ruleId string) (*responses.PortForwardActionResponse, error) {
 	var result responses.PortForwardActionResponse
-	url := fmt.Sprintf("%s/zone/%s/port-forward/%s", urls.BaseUrl, zoneId, ruleId)
-	if err := client.handleRequest(http.MethodDelete, url, nil, &result); err != nil {
+	url := fmt.Sprintf("%s/zone/%s/port-forward/%s", client.BaseURL, zoneId, ruleId)
+	if err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
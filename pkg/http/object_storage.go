@@ -2,29 +2,31 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	urls "github.com/virak-cloud/cli/pkg"
+	"iter"
 	"net/http"
 
 	"github.com/virak-cloud/cli/pkg/http/responses"
 )
 
-func (client *Client) GetObjectStorageBuckets(zoneId string) (*responses.ObjectStorageBucketsResponse, error) {
+func (client *Client) GetObjectStorageBuckets(ctx context.Context, zoneId string) (*responses.ObjectStorageBucketsResponse, error) {
 
 	var result responses.ObjectStorageBucketsResponse
-	url := fmt.Sprintf(urls.BucketList, urls.BaseUrl, zoneId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.BucketList, client.BaseURL, zoneId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) CreateObjectStorageBucket(zoneId string, name string, policy string) (*responses.ObjectStorageBucketCreationResponse, error) {
+func (client *Client) CreateObjectStorageBucket(ctx context.Context, zoneId string, name string, policy string) (*responses.ObjectStorageBucketCreationResponse, error) {
 
 	var result responses.ObjectStorageBucketCreationResponse
-	url := fmt.Sprintf(urls.BucketCreate, urls.BaseUrl, zoneId)
+	url := fmt.Sprintf(urls.BucketCreate, client.BaseURL, zoneId)
 
 	body, err := json.Marshal(map[string]string{
 		"name":   name,
@@ -34,18 +36,18 @@ func (client *Client) CreateObjectStorageBucket(zoneId string, name string, poli
 		return nil, err
 	}
 
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetObjectStorageBucket(zoneId string, bucketId string) (*responses.ObjectStorageBucketResponse, error) {
+func (client *Client) GetObjectStorageBucket(ctx context.Context, zoneId string, bucketId string) (*responses.ObjectStorageBucketResponse, error) {
 
 	var result responses.ObjectStorageBucketResponse
-	url := fmt.Sprintf(urls.BucketShow, urls.BaseUrl, zoneId, bucketId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.BucketShow, client.BaseURL, zoneId, bucketId)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +55,10 @@ func (client *Client) GetObjectStorageBucket(zoneId string, bucketId string) (*r
 
 }
 
-func (client *Client) UpdateObjectStorageBucket(zoneId string, bucketId string, policy string) (*responses.ObjectStorageBucketResponse, error) {
+func (client *Client) UpdateObjectStorageBucket(ctx context.Context, zoneId string, bucketId string, policy string) (*responses.ObjectStorageBucketResponse, error) {
 
 	var result responses.ObjectStorageBucketResponse
-	url := fmt.Sprintf(urls.BucketUpdate, urls.BaseUrl, zoneId, bucketId)
+	url := fmt.Sprintf(urls.BucketUpdate, client.BaseURL, zoneId, bucketId)
 
 	body, err := json.Marshal(map[string]string{
 		"policy": policy,
@@ -65,7 +67,7 @@ func (client *Client) UpdateObjectStorageBucket(zoneId string, bucketId string,
 		return nil, err
 	}
 
-	err = client.handleRequest(http.MethodPut, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPut, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +75,10 @@ func (client *Client) UpdateObjectStorageBucket(zoneId string, bucketId string,
 
 }
 
-func (client *Client) DeleteObjectStorageBucket(zoneId, bucketId string) error {
+func (client *Client) DeleteObjectStorageBucket(ctx context.Context, zoneId, bucketId string) error {
 
-	url := fmt.Sprintf(urls.BucketDelete, urls.BaseUrl, zoneId, bucketId)
-	err := client.handleRequest(http.MethodDelete, url, nil, nil)
+	url := fmt.Sprintf(urls.BucketDelete, client.BaseURL, zoneId, bucketId)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -84,22 +86,42 @@ func (client *Client) DeleteObjectStorageBucket(zoneId, bucketId string) error {
 
 }
 
-func (client *Client) GetObjectStorageEvents(zoneId string) (*responses.ObjectStorageEventsResponse, error) {
+func (client *Client) GetObjectStorageEvents(ctx context.Context, zoneId string, page PageOptions) (*responses.ObjectStorageEventsResponse, error) {
 	var result responses.ObjectStorageEventsResponse
-	url := fmt.Sprintf(urls.BucketsEventList, urls.BaseUrl, zoneId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := page.apply(fmt.Sprintf(urls.BucketsEventList, client.BaseURL, zoneId))
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetObjectStorageBucketEvents(zoneId string, bucketId string) (*responses.ObjectStorageEventsResponse, error) {
+func (client *Client) GetObjectStorageBucketEvents(ctx context.Context, zoneId string, bucketId string, page PageOptions) (*responses.ObjectStorageEventsResponse, error) {
 	var result responses.ObjectStorageEventsResponse
-	url := fmt.Sprintf(urls.BucketEventList, urls.BaseUrl, zoneId, bucketId)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := page.apply(fmt.Sprintf(urls.BucketEventList, client.BaseURL, zoneId, bucketId))
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// AllObjectStorageEvents lazily iterates over the object storage events of a zone,
+// or of a single bucket when bucketId is not empty, across all pages.
+func (client *Client) AllObjectStorageEvents(ctx context.Context, zoneId, bucketId string, start PageOptions) iter.Seq2[responses.ObjectStorageEvent, error] {
+	return Paginate(ctx, start, func(ctx context.Context, page PageOptions) ([]responses.ObjectStorageEvent, responses.Meta, error) {
+		var (
+			res *responses.ObjectStorageEventsResponse
+			err error
+		)
+		if bucketId != "" {
+			res, err = client.GetObjectStorageBucketEvents(ctx, zoneId, bucketId, page)
+		} else {
+			res, err = client.GetObjectStorageEvents(ctx, zoneId, page)
+		}
+		if err != nil {
+			return nil, responses.Meta{}, err
+		}
+		return res.Data, res.Meta, nil
+	})
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// PageOptions selects a page of a paginated endpoint. Zero values leave the
+// choice to the API (first page, server default page size).
+type PageOptions struct {
+	Page    int
+	PerPage int
+}
+
+// apply adds the page and per_page query parameters to rawURL.
+func (p PageOptions) apply(rawURL string) string {
+	if p.Page <= 0 && p.PerPage <= 0 {
+		return rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	if p.Page > 0 {
+		q.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// PageFetcher fetches a single page and returns its items and pagination metadata.
+type PageFetcher[T any] func(ctx context.Context, page PageOptions) ([]T, responses.Meta, error)
+
+// Paginate lazily walks every page starting at start.Page, yielding one item at
+// a time. Iteration stops at the last page, on the first error, or when the
+// consumer stops ranging.
+func Paginate[T any](ctx context.Context, start PageOptions, fetch PageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := start
+		if page.Page <= 0 {
+			page.Page = 1
+		}
+		for {
+			items, meta, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			// Stop when the API reports no further pages. Responses without
+			// metadata are treated as a single page.
+			if meta.LastPage <= meta.CurrentPage || meta.CurrentPage == 0 || len(items) == 0 {
+				return
+			}
+			page.Page = meta.CurrentPage + 1
+		}
+	}
+}
+
+// CollectPages drains seq into a slice, stopping at the first error.
+func CollectPages[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var out []T
+	for item, err := range seq {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// pages serves items in pages of perPage and records the pages requested.
+func pages(items []int, perPage int, requested *[]int) PageFetcher[int] {
+	lastPage := max((len(items)+perPage-1)/perPage, 1)
+	return func(ctx context.Context, page PageOptions) ([]int, responses.Meta, error) {
+		*requested = append(*requested, page.Page)
+		start := min((page.Page-1)*perPage, len(items))
+		end := min(start+perPage, len(items))
+		return items[start:end], responses.Meta{CurrentPage: page.Page, LastPage: lastPage, PerPage: perPage}, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	tests := []struct {
+		name  string
+		start PageOptions
+		want  []int
+		pages []int
+	}{
+		{"all pages", PageOptions{}, items, []int{1, 2, 3}},
+		{"from page 2", PageOptions{Page: 2}, []int{4, 5, 6, 7}, []int{2, 3}},
+		{"past the last page", PageOptions{Page: 5}, nil, []int{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []int
+			got, err := CollectPages(Paginate(context.Background(), tt.start, pages(items, 3, &requested)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(requested, tt.pages) {
+				t.Errorf("requested pages %v, want %v", requested, tt.pages)
+			}
+		})
+	}
+}
+
+func TestPaginateStopsEarly(t *testing.T) {
+	var requested []int
+	var got []int
+	for item, err := range Paginate(context.Background(), PageOptions{}, pages([]int{1, 2, 3, 4, 5, 6, 7}, 3, &requested)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+		if item == 4 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) || !slices.Equal(requested, []int{1, 2}) {
+		t.Errorf("got %v from pages %v, want [1 2 3 4] from pages [1 2]", got, requested)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	failure := errors.New("page 2 failed")
+	var requested []int
+	fetch := pages([]int{1, 2, 3, 4, 5, 6, 7}, 3, &requested)
+	got, err := CollectPages(Paginate(context.Background(), PageOptions{}, func(ctx context.Context, page PageOptions) ([]int, responses.Meta, error) {
+		if page.Page == 2 {
+			return nil, responses.Meta{}, failure
+		}
+		return fetch(ctx, page)
+	}))
+	if !errors.Is(err, failure) {
+		t.Errorf("got error %v, want %v", err, failure)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want the items before the error", got)
+	}
+}
+
+func TestPaginateWithoutMeta(t *testing.T) {
+	calls := 0
+	got, err := CollectPages(Paginate(context.Background(), PageOptions{}, func(ctx context.Context, page PageOptions) ([]int, responses.Meta, error) {
+		calls++
+		return []int{1, 2}, responses.Meta{}, nil
+	}))
+	if err != nil || !slices.Equal(got, []int{1, 2}) || calls != 1 {
+		t.Errorf("got %v, %v after %d calls, want one page", got, err, calls)
+	}
+}
+
+func TestAllDNSEvents(t *testing.T) {
+	srv := httptest.NewServer(fake.NewServer())
+	defer srv.Close()
+	client := NewClient("token", WithBaseURL(srv.URL))
+	ctx := context.Background()
+
+	for i := range 5 {
+		if _, err := client.CreateDomain(ctx, fmt.Sprintf("example%d.com", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	events, err := CollectPages(client.AllDNSEvents(ctx, PageOptions{PerPage: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var domains []string
+	for _, e := range events {
+		domains = append(domains, e.ProductID)
+	}
+	want := []string{"example4.com", "example3.com", "example2.com", "example1.com", "example0.com"}
+	if !slices.Equal(domains, want) {
+		t.Errorf("got events of %v, want %v", domains, want)
+	}
+
+	page, err := client.GetDNSEvents(ctx, PageOptions{Page: 3, PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Data) != 1 || page.Meta.LastPage != 3 || page.Meta.Total != 5 {
+		t.Errorf("got %d events and meta %+v, want the last event on page 3 of 3", len(page.Data), page.Meta)
+	}
+}
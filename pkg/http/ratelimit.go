@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// RateLimit throttles the requests issued by a client. The limits are shared by
+// every goroutine using the same Client. Zero values disable the respective limit.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate of the token bucket.
+	RequestsPerSecond float64
+	// Burst is the bucket size, i.e. how many requests may be sent back to back.
+	// It defaults to 1 when RequestsPerSecond is set.
+	Burst int
+	// MaxInFlight caps the number of concurrent requests.
+	MaxInFlight int
+}
+
+// WithRateLimit enables client-side throttling.
+func WithRateLimit(limit RateLimit) Option {
+	return func(c *Client) {
+		c.limiter = newLimiter(limit)
+	}
+}
+
+// limiter combines a token bucket with a semaphore for in-flight requests.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{}
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	if limit.RequestsPerSecond <= 0 && limit.MaxInFlight <= 0 {
+		return nil
+	}
+	l := &limiter{}
+	if limit.RequestsPerSecond > 0 {
+		l.rate = limit.RequestsPerSecond
+		l.burst = float64(max(limit.Burst, 1))
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until the request may be sent. The returned function must be
+// called once the request has completed.
+func (l *limiter) acquire(ctx context.Context, method, url string) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if wait := l.reserve(); wait > 0 {
+		slog.Debug("rate limit reached, delaying API request", "method", method, "url", url, "wait", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+	default:
+		slog.Debug("too many requests in flight, waiting for a free slot", "method", method, "url", url, "maxInFlight", cap(l.inFlight))
+		start := time.Now()
+		select {
+		case l.inFlight <- struct{}{}:
+			slog.Debug("acquired in-flight slot", "method", method, "url", url, "waited", time.Since(start))
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return func() { <-l.inFlight }, nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to
+// wait before the token becomes valid.
+func (l *limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+)
+
+// TokenRefresher returns a new token after the API rejected the current one
+// with 401 Unauthorized.
+type TokenRefresher func(ctx context.Context) (string, error)
+
+// WithTokenRefresh retries a request once with a fresh token from refresh when
+// it fails with 401. It suits short-lived tokens issued by credential helpers.
+func WithTokenRefresh(refresh TokenRefresher) Option {
+	return func(c *Client) {
+		c.refresh = refresh
+	}
+}
+
+func (client *Client) currentToken() string {
+	client.tokenMu.Lock()
+	defer client.tokenMu.Unlock()
+	return client.Token
+}
+
+// refreshToken replaces the client's token after a 401. It reports whether the
+// request should be sent again: false when no refresher is set, it failed, or
+// it returned the token that was just rejected.
+func (client *Client) refreshToken(ctx context.Context, rejected string) bool {
+	if client.refresh == nil {
+		return false
+	}
+	token, err := client.refresh(ctx)
+	if err != nil {
+		slog.Warn("failed to refresh API token", "error", err)
+		return false
+	}
+	if token == "" || token == rejected {
+		return false
+	}
+	client.tokenMu.Lock()
+	client.Token = token
+	client.tokenMu.Unlock()
+	slog.Info("API token refreshed after 401")
+	return true
+}
@@ -2,12 +2,15 @@ package responses
 
 // DataCenter represents the response for the zone list endpoint.
 type DataCenter struct {
-	Data []struct {
-		ID       string `json:"id"`
-		Name     string `json:"name"`
-		Location string `json:"location"`
-		Active   bool   `json:"active"`
-	} `json:"data"`
+	Data []Zone `json:"data"`
+}
+
+// Zone represents a zone in the zone list.
+type Zone struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+	Active   bool   `json:"active"`
 }
 
 // ZoneActiveServicesResponse represents the response for the zone active services endpoint.
@@ -122,4 +122,5 @@ type Expense struct {
 // ExpensesListResponse represents the response for the expenses list endpoint.
 type ExpensesListResponse struct {
 	Data []Expense `json:"data"`
+	Meta Meta      `json:"meta"`
 }
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+//
+// Transport failures (connection resets, timeouts) and 429, 502, 503 and 504
+// responses are retried. Only idempotent methods are retried unless
+// RetryNonIdempotent is set; 429 responses are always safe to retry because the
+// server rejected the request without processing it.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retrying.
+	MaxRetries int
+	// MinBackoff is the base delay before the first retry; it doubles on every attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed delay and any Retry-After value.
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows POST and PATCH requests to be retried as well.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is used by NewClient. It does not retry.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 0,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 30 * time.Second,
+}
+
+// WithRetryPolicy replaces the client's retry policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.Retry = policy
+	}
+}
+
+// WithRetries sets the number of retries, keeping the rest of the policy.
+func WithRetries(retries int) Option {
+	return func(c *Client) {
+		c.Retry.MaxRetries = retries
+	}
+}
+
+// backoff returns how long to wait before retry number attempt (starting at 0)
+// after err. ok is false when err must not be retried.
+func (p RetryPolicy) backoff(ctx context.Context, method string, attempt int, err error) (wait time.Duration, ok bool) {
+	if err == nil || attempt >= p.MaxRetries || ctx.Err() != nil {
+		return 0, false
+	}
+
+	idempotent := p.RetryNonIdempotent || isIdempotent(method)
+	if apiErr, isAPIErr := AsAPIError(err); isAPIErr {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests:
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			if !idempotent {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+		if apiErr.RetryAfter > 0 {
+			return min(apiErr.RetryAfter, p.MaxBackoff), true
+		}
+	} else {
+		var urlErr *url.Error
+		if !errors.As(err, &urlErr) || !idempotent {
+			return 0, false
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrNotRecorded) || errors.Is(err, ErrDryRun) {
+			return 0, false
+		}
+	}
+
+	wait = p.MinBackoff << attempt
+	if wait <= 0 || wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	// Equal jitter: keep half of the delay and randomize the other half.
+	half := wait / 2
+	return half + rand.N(half+1), true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
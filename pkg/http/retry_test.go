@@ -0,0 +1,190 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetries retries quickly so tests do not sleep for long.
+var fastRetries = RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+// failingServer answers the first failures requests with status and the rest
+// with an empty list. It counts the requests it receives.
+func failingServer(t *testing.T, failures int, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(calls.Add(1)) <= failures {
+			for name, values := range header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message":"try again"}`))
+			return
+		}
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+// send makes a request with method to one of the API endpoints.
+func send(ctx context.Context, client *Client, method string) error {
+	var err error
+	switch method {
+	case http.MethodGet:
+		_, err = client.GetZoneList(ctx)
+	case http.MethodPost:
+		_, err = client.CreateDomain(ctx, "example.com")
+	case http.MethodDelete:
+		_, err = client.DeleteDomain(ctx, "example.com")
+	default:
+		panic("no endpoint for " + method)
+	}
+	return err
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		srv, calls := failingServer(t, 2, status, nil)
+		client := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(fastRetries))
+
+		if err := send(context.Background(), client, http.MethodGet); err != nil {
+			t.Errorf("status %d: got error %v, want success after retrying", status, err)
+		}
+		if got := calls.Load(); got != 3 {
+			t.Errorf("status %d: got %d requests, want 3", status, got)
+		}
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	srv, calls := failingServer(t, 10, http.StatusServiceUnavailable, nil)
+	client := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(fastRetries))
+
+	err := send(context.Background(), client, http.MethodGet)
+	if apiErr, ok := AsAPIError(err); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("got error %v, want the 503 of the last attempt", err)
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("got %d requests, want 1 attempt and 3 retries", got)
+	}
+}
+
+func TestRetryIdempotentOnly(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		policy RetryPolicy
+		calls  int32
+	}{
+		{"POST on 503", http.MethodPost, http.StatusServiceUnavailable, fastRetries, 1},
+		{"POST on 429", http.MethodPost, http.StatusTooManyRequests, fastRetries, 3},
+		{"POST on 503 with RetryNonIdempotent", http.MethodPost, http.StatusServiceUnavailable, RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryNonIdempotent: true}, 3},
+		{"DELETE on 503", http.MethodDelete, http.StatusServiceUnavailable, fastRetries, 3},
+		{"GET on 500", http.MethodGet, http.StatusInternalServerError, fastRetries, 1},
+		{"GET on 404", http.MethodGet, http.StatusNotFound, fastRetries, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, calls := failingServer(t, 2, tt.status, nil)
+			client := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(tt.policy))
+			send(context.Background(), client, tt.method)
+			if got := calls.Load(); got != tt.calls {
+				t.Errorf("got %d requests, want %d", got, tt.calls)
+			}
+		})
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	srv, calls := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+	policy := RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Second}
+	client := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(policy))
+
+	start := time.Now()
+	if err := send(context.Background(), client, http.MethodGet); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want the 1s of Retry-After", elapsed)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	srv, calls := failingServer(t, 10, http.StatusServiceUnavailable, http.Header{"Retry-After": {"30"}})
+	policy := RetryPolicy{MaxRetries: 5, MinBackoff: time.Millisecond, MaxBackoff: time.Minute}
+	client := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := send(ctx, client, http.MethodGet); err == nil {
+		t.Fatal("got no error, want the 503")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 10, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	unavailable := &APIError{StatusCode: http.StatusServiceUnavailable}
+	ctx := context.Background()
+
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		wait, ok := policy.backoff(ctx, http.MethodGet, attempt, unavailable)
+		if !ok {
+			t.Fatalf("attempt %d: not retried", attempt)
+		}
+		// Equal jitter keeps at least half of the delay
+		if wait < want/2 || wait > want {
+			t.Errorf("attempt %d: waited %s, want between %s and %s", attempt, wait, want/2, want)
+		}
+	}
+
+	limited := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 300 * time.Millisecond}
+	if wait, _ := policy.backoff(ctx, http.MethodGet, 0, limited); wait != 300*time.Millisecond {
+		t.Errorf("got %s, want the Retry-After of 300ms", wait)
+	}
+	limited.RetryAfter = time.Hour
+	if wait, _ := policy.backoff(ctx, http.MethodGet, 0, limited); wait != policy.MaxBackoff {
+		t.Errorf("got %s, want Retry-After capped at MaxBackoff", wait)
+	}
+
+	if _, ok := policy.backoff(ctx, http.MethodGet, 10, unavailable); ok {
+		t.Error("retried beyond MaxRetries")
+	}
+	if _, ok := policy.backoff(ctx, http.MethodGet, 0, errors.New("decoding failed")); ok {
+		t.Error("retried an error that is neither an API nor a transport error")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"", 0, 0},
+		{"0", 0, 0},
+		{"5", 5 * time.Second, 5 * time.Second},
+		{"-3", 0, 0},
+		{"soon", 0, 0},
+		{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+		{time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+			t.Errorf("parseRetryAfter(%q) = %s, want between %s and %s", tt.value, got, tt.min, tt.max)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SecretFields lists the JSON members whose values are never written to logs.
+// Matching is case-insensitive.
+var SecretFields = []string{"password", "presharedkey", "secret_key", "access_key"}
+
+// redactedHeaders lists the headers whose values are never written to logs.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+const redacted = "[REDACTED]"
+
+// maxTracedBody limits how much of a request or response body is logged.
+const maxTracedBody = 64 * 1024
+
+// TracingTransport is an http.RoundTripper that logs every request and its
+// response at debug level. Credentials are redacted before logging.
+type TracingTransport struct {
+	// Base is the wrapped transport; http.DefaultTransport is used when nil.
+	Base http.RoundTripper
+	// Logger receives the trace records; slog.Default() is used when nil.
+	Logger *slog.Logger
+	// Bodies enables logging of request and response bodies.
+	Bodies bool
+}
+
+// WithTracing wraps the client's transport in a TracingTransport.
+func WithTracing(logger *slog.Logger, bodies bool) Option {
+	return func(c *Client) {
+		c.HttpClient.Transport = &TracingTransport{
+			Base:   c.HttpClient.Transport,
+			Logger: logger,
+			Bodies: bodies,
+		}
+	}
+}
+
+func (t *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	logger := t.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	attrs := []any{"method", req.Method, "url", req.URL.String(), "headers", RedactHeaders(req.Header)}
+	if t.Bodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(io.LimitReader(body, maxTracedBody))
+			body.Close()
+			attrs = append(attrs, "body", string(RedactBody(payload)))
+		}
+	}
+	logger.DebugContext(req.Context(), "API request", attrs...)
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		logger.DebugContext(req.Context(), "API request failed", "method", req.Method, "url", req.URL.String(), "latency", latency, "error", err)
+		return nil, err
+	}
+
+	attrs = []any{"method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "latency", latency, "headers", RedactHeaders(resp.Header)}
+	if t.Bodies && resp.Body != nil {
+		payload, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(payload))
+		if readErr != nil {
+			return nil, readErr
+		}
+		if len(payload) > maxTracedBody {
+			payload = payload[:maxTracedBody]
+		}
+		attrs = append(attrs, "body", string(RedactBody(payload)))
+	}
+	logger.DebugContext(req.Context(), "API response", attrs...)
+	return resp, nil
+}
+
+// RedactHeaders returns a copy of h with credential headers masked.
+func RedactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range redactedHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, redacted)
+		}
+	}
+	return out
+}
+
+// RedactBody masks the values of SecretFields in a JSON document. Bodies that
+// are not JSON are returned unchanged.
+func RedactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return body
+	}
+	out, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if isSecretField(k) {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redactValue(child)
+		}
+	case []interface{}:
+		for i, child := range val {
+			val[i] = redactValue(child)
+		}
+	}
+	return v
+}
+
+func isSecretField(name string) bool {
+	for _, field := range SecretFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTracingTransportRedacts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "cookie-secret"})
+		w.Write([]byte(`{"data":{"success":true,"name":"bucket","access_key":"AKIA-SECRET","secret_key":"S3CR3T","nested":[{"Password":"hunter2"}]}}`))
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient("token-secret", WithBaseURL(srv.URL), WithTracing(logger, true))
+
+	body := map[string]any{"name": "db", "password": "p4ssw0rd", "presharedKey": "psk-secret"}
+	resp, err := client.CreateIPv4FirewallRule(context.Background(), "zone", "network", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Data.Success {
+		t.Error("the traced response did not reach the client")
+	}
+
+	out := logs.String()
+	for _, secret := range []string{"token-secret", "cookie-secret", "AKIA-SECRET", "S3CR3T", "hunter2", "p4ssw0rd", "psk-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("trace log contains %q:\n%s", secret, out)
+		}
+	}
+	for _, kept := range []string{`API request`, `API response`, `\"name\":\"db\"`, `\"name\":\"bucket\"`, redacted} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("trace log lacks %s:\n%s", kept, out)
+		}
+	}
+}
+
+func TestTracingTransportWithoutBodies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":"z1","name":"Tehran-1"}]}`))
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient("token-secret", WithBaseURL(srv.URL), WithTracing(logger, false))
+	zones, err := client.GetZoneList(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zones.Data) != 1 {
+		t.Errorf("got %d zones, want the response to reach the client unchanged", len(zones.Data))
+	}
+	if out := logs.String(); strings.Contains(out, "Tehran-1") || !strings.Contains(out, `"status":200`) {
+		t.Errorf("got trace log %s, want the status without the body", out)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"password":"x","name":"y"}`, `{"name":"y","password":"[REDACTED]"}`},
+		{`[{"SECRET_KEY":"x"},{"list":[{"access_key":"y"}]}]`, `[{"SECRET_KEY":"[REDACTED]"},{"list":[{"access_key":"[REDACTED]"}]}]`},
+		{`{"password":{"nested":"x"}}`, `{"password":"[REDACTED]"}`},
+		{`not json password=x`, `not json password=x`},
+		{``, ``},
+	}
+	for _, tt := range tests {
+		if got := string(RedactBody([]byte(tt.body))); got != tt.want {
+			t.Errorf("RedactBody(%s) = %s, want %s", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{"Authorization": {"Bearer x"}, "Cookie": {"a=b"}, "Accept": {"application/json"}}
+	got := RedactHeaders(h)
+	if got.Get("Authorization") != redacted || got.Get("Cookie") != redacted || got.Get("Accept") != "application/json" {
+		t.Errorf("got %v, want credentials redacted and other headers kept", got)
+	}
+	if h.Get("Authorization") != "Bearer x" {
+		t.Error("RedactHeaders changed the original headers")
+	}
+}
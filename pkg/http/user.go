@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	urls "github.com/virak-cloud/cli/pkg"
@@ -12,10 +13,10 @@ import (
 // User-related API methods for the Client
 
 // GetUserProfile fetches the user's profile information.
-func (client *Client) GetUserProfile() (*responses.UserProfileResponse, error) {
+func (client *Client) GetUserProfile(ctx context.Context) (*responses.UserProfileResponse, error) {
 	var result responses.UserProfileResponse
-	url := fmt.Sprintf(urls.UserProfile, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserProfile, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -23,21 +24,29 @@ func (client *Client) GetUserProfile() (*responses.UserProfileResponse, error) {
 }
 
 // ValidateUserToken checks if the user's token is valid (204 No Content on success).
-func (client *Client) ValidateUserToken() error {
-	url := fmt.Sprintf(urls.UserTokenValidate, urls.BaseUrl)
-	err := client.handleRequest("GET", url, nil, nil)
+func (client *Client) ValidateUserToken(ctx context.Context) error {
+	url := fmt.Sprintf(urls.UserTokenValidate, client.BaseURL)
+	err := client.handleRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
 		return fmt.Errorf("token validation failed: %w", err)
 	}
 	return nil
 }
 
+// RevokeUserToken revokes the client's token on the server. The endpoint is
+// not documented, so APIs may not offer it; they answer with 404 or 405, an
+// error matched by IsUnsupported, which callers should not treat as a failure.
+func (client *Client) RevokeUserToken(ctx context.Context) error {
+	url := fmt.Sprintf(urls.UserTokenRevoke, client.BaseURL)
+	return client.handleRequest(ctx, http.MethodDelete, url, nil, nil)
+}
+
 // GetUserTokenAbilities fetches the abilities associated with the user's token.
-func (client *Client) GetUserTokenAbilities() (*responses.UserTokenAbilitiesResponse, error) {
+func (client *Client) GetUserTokenAbilities(ctx context.Context) (*responses.UserTokenAbilitiesResponse, error) {
 
 	var result responses.UserTokenAbilitiesResponse
-	url := fmt.Sprintf(urls.UserTokenAbilities, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserTokenAbilities, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -48,10 +57,10 @@ func (client *Client) GetUserTokenAbilities() (*responses.UserTokenAbilitiesResp
 // SSH Key Management
 
 // ListUserSSHKeys fetches the list of user's SSH keys.
-func (client *Client) ListUserSSHKeys() (*responses.UserSSHKeyListResponse, error) {
+func (client *Client) ListUserSSHKeys(ctx context.Context) (*responses.UserSSHKeyListResponse, error) {
 	var result responses.UserSSHKeyListResponse
-	url := fmt.Sprintf(urls.UserSSHKeyList, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserSSHKeyList, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -59,16 +68,16 @@ func (client *Client) ListUserSSHKeys() (*responses.UserSSHKeyListResponse, erro
 }
 
 // AddUserSSHKey adds a new SSH key for the user. Pass body as JSON: {"name":"My SSH Key","ssh_key":"ssh-rsa ..."}
-func (client *Client) AddUserSSHKey(sshKeyName string, sshkey string) (*responses.AddUserSSHKeyResponse, error) {
+func (client *Client) AddUserSSHKey(ctx context.Context, sshKeyName string, sshkey string) (*responses.AddUserSSHKeyResponse, error) {
 
 	var result responses.AddUserSSHKeyResponse
-	url := fmt.Sprintf(urls.UserSSHKeyCreate, urls.BaseUrl)
+	url := fmt.Sprintf(urls.UserSSHKeyCreate, client.BaseURL)
 	body, err := json.Marshal(map[string]string{"name": sshKeyName, "ssh_key": sshkey})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal ssh: %w", err)
 	}
 
-	err = client.handleRequest(http.MethodPost, url, bytes.NewBuffer(body), &result)
+	err = client.handleRequest(ctx, http.MethodPost, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +86,10 @@ func (client *Client) AddUserSSHKey(sshKeyName string, sshkey string) (*response
 }
 
 // DeleteUserSSHKey deletes a user's SSH key by its ID.
-func (client *Client) DeleteUserSSHKey(sshKeyId string) (*responses.DeleteUserSSHKeyResponse, error) {
+func (client *Client) DeleteUserSSHKey(ctx context.Context, sshKeyId string) (*responses.DeleteUserSSHKeyResponse, error) {
 	var result responses.DeleteUserSSHKeyResponse
-	url := fmt.Sprintf(urls.UserSSHKeyDelete, urls.BaseUrl, sshKeyId)
-	err := client.handleRequest(http.MethodDelete, url, nil, &result)
+	url := fmt.Sprintf(urls.UserSSHKeyDelete, client.BaseURL, sshKeyId)
+	err := client.handleRequest(ctx, http.MethodDelete, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -91,10 +100,10 @@ func (client *Client) DeleteUserSSHKey(sshKeyId string) (*responses.DeleteUserSS
 // Finance
 
 // GetWalletsBalance fetches the user's wallet balance.
-func (client *Client) GetWalletsBalance() (*responses.WalletsBalanceResponse, error) {
+func (client *Client) GetWalletsBalance(ctx context.Context) (*responses.WalletsBalanceResponse, error) {
 	var result responses.WalletsBalanceResponse
-	url := fmt.Sprintf(urls.UserBalance, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserBalance, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -103,14 +112,14 @@ func (client *Client) GetWalletsBalance() (*responses.WalletsBalanceResponse, er
 }
 
 // GetCostDocumentsYearly fetches yearly cost documents. Pass body as JSON: {"year":1402}
-func (client *Client) GetCostDocumentsYearly(year int) (*responses.CostDocumentsYearlyResponse, error) {
+func (client *Client) GetCostDocumentsYearly(ctx context.Context, year int) (*responses.CostDocumentsYearlyResponse, error) {
 	var result responses.CostDocumentsYearlyResponse
 	body, err := json.Marshal(map[string]int{"year": year})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal year: %w", err)
 	}
-	url := fmt.Sprintf(urls.UserCostDocumentList, urls.BaseUrl)
-	err = client.handleRequest(http.MethodGet, url, bytes.NewBuffer(body), &result)
+	url := fmt.Sprintf(urls.UserCostDocumentList, client.BaseURL)
+	err = client.handleRequest(ctx, http.MethodGet, url, bytes.NewBuffer(body), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -118,21 +127,21 @@ func (client *Client) GetCostDocumentsYearly(year int) (*responses.CostDocuments
 }
 
 // GetPaymentList fetches the user's payment list.
-func (client *Client) GetPaymentList() (*responses.PaymentListResponse, error) {
+func (client *Client) GetPaymentList(ctx context.Context) (*responses.PaymentListResponse, error) {
 	var result responses.PaymentListResponse
-	url := fmt.Sprintf(urls.UserPaymentList, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserPaymentList, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (client *Client) GetTokenAbilities() (*responses.UserTokenAbilitiesResponse, error) {
+func (client *Client) GetTokenAbilities(ctx context.Context) (*responses.UserTokenAbilitiesResponse, error) {
 
 	var result responses.UserTokenAbilitiesResponse
-	url := fmt.Sprintf(urls.UserTokenAbilities, urls.BaseUrl)
-	err := client.handleRequest(http.MethodGet, url, nil, &result)
+	url := fmt.Sprintf(urls.UserTokenAbilities, client.BaseURL)
+	err := client.handleRequest(ctx, http.MethodGet, url, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,383 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/virak-cloud/cli/pkg/http/responses"
+)
+
+// ErrWaitTimeout is returned by Wait when the resource did not reach the target
+// state before WaitOptions.Timeout elapsed.
+var ErrWaitTimeout = errors.New("timed out waiting for the operation to complete")
+
+// ResourceState is a snapshot of an asynchronously changing resource.
+type ResourceState struct {
+	Status string
+	// Failed is set when the resource reports a failure independently of its status.
+	Failed bool
+	// Reason explains a failure, e.g. the cluster's failure message.
+	Reason string
+	// Gone is set when the resource does not exist (anymore).
+	Gone bool
+}
+
+// PollFunc fetches the current state of a resource.
+type PollFunc func(ctx context.Context) (ResourceState, error)
+
+// WaitOptions configures Wait.
+type WaitOptions struct {
+	// Target lists the statuses that end the wait successfully (case-insensitive).
+	Target []string
+	// From lists statuses the resource must leave before Target counts, for
+	// operations that end in the status they start from, e.g. a reboot goes
+	// from UP to UP.
+	From []string
+	// FromPolls ends the wait successfully when the resource reports a Target
+	// status this many polls in a row without having left From, as operations
+	// that finish between two polls never show another status. It defaults to
+	// DefaultFromPolls.
+	FromPolls int
+	// Failure lists statuses that end the wait with a *WaitFailedError. It defaults to DefaultFailureStatuses.
+	Failure []string
+	// UntilGone waits for the resource to disappear instead of reaching Target.
+	UntilGone bool
+	// Interval between polls; defaults to DefaultWaitInterval.
+	Interval time.Duration
+	// Timeout bounds the whole wait; zero means no limit besides ctx.
+	Timeout time.Duration
+	// Progress, when set, is called whenever the observed state changes.
+	Progress func(state ResourceState, elapsed time.Duration)
+}
+
+// Statuses reported by the API that the CLI waits for.
+const (
+	InstanceStatusUp         = "UP"
+	InstanceStatusDown       = "DOWN"
+	SnapshotStatusReady      = "READY"
+	ClusterStatusRunning     = "Running"
+	ClusterStatusStopped     = "Stopped"
+	BucketStatusActive       = "Active"
+	NetworkStatusAllocated   = "Allocated"
+	NetworkStatusImplemented = "Implemented"
+	VolumeStatusAllocated    = "Allocated"
+	VolumeStatusReady        = "Ready"
+)
+
+// InstanceStatusReverting is reported by PollInstanceRevert, not the API, until
+// the snapshot the instance is reverted to becomes its current one.
+const InstanceStatusReverting = "REVERTING"
+
+// DefaultWaitInterval is the poll interval used when WaitOptions.Interval is zero.
+const DefaultWaitInterval = 5 * time.Second
+
+// DefaultFromPolls is the number of polls used when WaitOptions.FromPolls is zero.
+const DefaultFromPolls = 3
+
+// DefaultFailureStatuses are the statuses treated as terminal failures.
+var DefaultFailureStatuses = []string{"Failed", "Error"}
+
+// WaitFailedError is returned by Wait when the resource reached a failure state.
+type WaitFailedError struct {
+	Status string
+	Reason string
+}
+
+func (e *WaitFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("operation failed with status %q: %s", e.Status, e.Reason)
+	}
+	return fmt.Sprintf("operation failed with status %q", e.Status)
+}
+
+// Wait polls until the resource reaches one of opts.Target (or disappears when
+// opts.UntilGone is set), a failure state, the timeout or ctx is done. It
+// returns the last observed state.
+func Wait(ctx context.Context, poll PollFunc, opts WaitOptions) (ResourceState, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	failure := opts.Failure
+	if failure == nil {
+		failure = DefaultFailureStatuses
+	}
+	fromPolls := opts.FromPolls
+	if fromPolls <= 0 {
+		fromPolls = DefaultFromPolls
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	var last ResourceState
+	left := len(opts.From) == 0
+	stayed := 0
+	for first := true; ; first = false {
+		state, err := poll(ctx)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() != nil {
+				return last, ErrWaitTimeout
+			}
+			return last, err
+		}
+		if opts.Progress != nil && (first || state != last) {
+			opts.Progress(state, time.Since(start))
+		}
+		last = state
+		if !state.Gone && !statusIn(state.Status, opts.From) {
+			left = true
+		}
+		if !left && !state.Gone && statusIn(state.Status, opts.Target) {
+			stayed++
+		} else {
+			stayed = 0
+		}
+
+		switch {
+		case opts.UntilGone && state.Gone:
+			return state, nil
+		case state.Failed || (!state.Gone && statusIn(state.Status, failure)):
+			return state, &WaitFailedError{Status: state.Status, Reason: state.Reason}
+		case !opts.UntilGone && !state.Gone && left && statusIn(state.Status, opts.Target):
+			return state, nil
+		case !opts.UntilGone && stayed >= fromPolls:
+			return state, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return last, ErrWaitTimeout
+			}
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func statusIn(status string, statuses []string) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(status, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// goneOnNotFound converts a 404 from a show endpoint into a Gone state.
+func goneOnNotFound(state ResourceState, err error) (ResourceState, error) {
+	if IsNotFound(err) {
+		return ResourceState{Gone: true}, nil
+	}
+	return state, err
+}
+
+// pollNew lists the resources before a create and returns a PollFunc for the
+// one named name that was not among them. Create requests do not return the
+// new ID, and an older resource with the same name must not be taken for it.
+func pollNew[T any](ctx context.Context, list func(context.Context) ([]T, error), name string, fields func(T) (id, name string), stateOf func(T) ResourceState) (PollFunc, error) {
+	items, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(items))
+	for _, item := range items {
+		id, _ := fields(item)
+		existing[id] = true
+	}
+	return func(ctx context.Context) (ResourceState, error) {
+		items, err := list(ctx)
+		if err != nil {
+			return ResourceState{}, err
+		}
+		for _, item := range items {
+			if id, itemName := fields(item); itemName == name && !existing[id] {
+				return stateOf(item), nil
+			}
+		}
+		return ResourceState{Gone: true}, nil
+	}, nil
+}
+
+func instanceState(i responses.Instance) ResourceState {
+	return ResourceState{Status: i.Status}
+}
+
+// PollInstance polls an instance by ID.
+func (client *Client) PollInstance(zoneId, instanceId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.ShowInstance(ctx, zoneId, instanceId)
+		if err != nil {
+			return goneOnNotFound(ResourceState{}, err)
+		}
+		return instanceState(res.Data), nil
+	}
+}
+
+// PollNewInstance polls the instance named name that is created after the
+// call, see pollNew. Call it before the create request.
+func (client *Client) PollNewInstance(ctx context.Context, zoneId, name string) (PollFunc, error) {
+	return pollNew(ctx, func(ctx context.Context) ([]responses.Instance, error) {
+		res, err := client.ListInstances(ctx, zoneId)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data, nil
+	}, name, func(i responses.Instance) (string, string) { return i.ID, i.Name }, instanceState)
+}
+
+// PollNewInstanceSnapshot polls the snapshot of an instance named name that is
+// created after the call, see pollNew. Call it before the create request.
+func (client *Client) PollNewInstanceSnapshot(ctx context.Context, zoneId, instanceId, name string) (PollFunc, error) {
+	return pollNew(ctx, func(ctx context.Context) ([]responses.InstanceSnapshot, error) {
+		res, err := client.ShowInstance(ctx, zoneId, instanceId)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data.Snapshot, nil
+	}, name, func(s responses.InstanceSnapshot) (string, string) { return s.ID, s.Name }, snapshotState)
+}
+
+func snapshotState(s responses.InstanceSnapshot) ResourceState {
+	return ResourceState{Status: s.Status}
+}
+
+// PollInstanceSnapshot polls a snapshot of an instance by ID.
+func (client *Client) PollInstanceSnapshot(zoneId, instanceId, snapshotId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.ShowInstance(ctx, zoneId, instanceId)
+		if err != nil {
+			return ResourceState{}, err
+		}
+		for _, snap := range res.Data.Snapshot {
+			if snap.ID == snapshotId {
+				return snapshotState(snap), nil
+			}
+		}
+		return ResourceState{Gone: true}, nil
+	}
+}
+
+// PollInstanceRevert polls an instance being reverted to a snapshot. Its status
+// is InstanceStatusReverting until the snapshot is the current one.
+func (client *Client) PollInstanceRevert(zoneId, instanceId, snapshotId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.ShowInstance(ctx, zoneId, instanceId)
+		if err != nil {
+			return ResourceState{}, err
+		}
+		for _, snap := range res.Data.Snapshot {
+			if snap.ID == snapshotId && snap.Current {
+				return instanceState(res.Data), nil
+			}
+		}
+		return ResourceState{Status: InstanceStatusReverting}, nil
+	}
+}
+
+// PollInstanceVolume polls a volume by ID. The API has no endpoint showing a
+// single volume, so it lists them.
+func (client *Client) PollInstanceVolume(zoneId, volumeId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.ListInstanceVolumes(ctx, zoneId)
+		if err != nil {
+			return ResourceState{}, err
+		}
+		for _, v := range res.Data {
+			if v.ID == volumeId {
+				return ResourceState{Status: v.Status}, nil
+			}
+		}
+		return ResourceState{Gone: true}, nil
+	}
+}
+
+func bucketState(b responses.ObjectStorageBucket) ResourceState {
+	return ResourceState{Status: b.Status, Failed: b.IsFailed, Reason: b.Message}
+}
+
+// PollBucket polls an object storage bucket by ID.
+func (client *Client) PollBucket(zoneId, bucketId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.GetObjectStorageBucket(ctx, zoneId, bucketId)
+		if err != nil {
+			return goneOnNotFound(ResourceState{}, err)
+		}
+		return bucketState(res.Data), nil
+	}
+}
+
+// PollNewBucket polls the object storage bucket named name that is created
+// after the call, see pollNew. Call it before the create request.
+func (client *Client) PollNewBucket(ctx context.Context, zoneId, name string) (PollFunc, error) {
+	return pollNew(ctx, func(ctx context.Context) ([]responses.ObjectStorageBucket, error) {
+		res, err := client.GetObjectStorageBuckets(ctx, zoneId)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data, nil
+	}, name, func(b responses.ObjectStorageBucket) (string, string) { return b.ID, b.Name }, bucketState)
+}
+
+func clusterState(c responses.KubernetesCluster) ResourceState {
+	return ResourceState{Status: c.Status, Reason: c.FailedReason}
+}
+
+// PollKubernetesCluster polls a Kubernetes cluster by ID.
+func (client *Client) PollKubernetesCluster(zoneId, clusterId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.GetKubernetesCluster(ctx, zoneId, clusterId)
+		if err != nil {
+			return goneOnNotFound(ResourceState{}, err)
+		}
+		return clusterState(res.Data), nil
+	}
+}
+
+// PollNewKubernetesCluster polls the Kubernetes cluster named name that is
+// created after the call, see pollNew. Call it before the create request.
+func (client *Client) PollNewKubernetesCluster(ctx context.Context, zoneId, name string) (PollFunc, error) {
+	return pollNew(ctx, func(ctx context.Context) ([]responses.KubernetesCluster, error) {
+		res, err := client.GetKubernetesClusters(ctx, zoneId)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data, nil
+	}, name, func(c responses.KubernetesCluster) (string, string) { return c.ID, c.Name }, clusterState)
+}
+
+func networkState(n responses.Network) ResourceState {
+	return ResourceState{Status: n.Status}
+}
+
+// PollNetwork polls a network by ID.
+func (client *Client) PollNetwork(zoneId, networkId string) PollFunc {
+	return func(ctx context.Context) (ResourceState, error) {
+		res, err := client.ShowNetwork(ctx, zoneId, networkId)
+		if err != nil {
+			return goneOnNotFound(ResourceState{}, err)
+		}
+		return networkState(res.Data), nil
+	}
+}
+
+// PollNewNetwork polls the network named name that is created after the call,
+// see pollNew. Call it before the create request.
+func (client *Client) PollNewNetwork(ctx context.Context, zoneId, name string) (PollFunc, error) {
+	return pollNew(ctx, func(ctx context.Context) ([]responses.Network, error) {
+		res, err := client.ListNetworks(ctx, zoneId)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data, nil
+	}, name, func(n responses.Network) (string, string) { return n.ID, n.Name }, networkState)
+}
@@ -0,0 +1,258 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/virak-cloud/cli/pkg/http/fake"
+)
+
+// scripted returns a PollFunc reporting states in order, repeating the last one.
+func scripted(states ...ResourceState) (PollFunc, *int) {
+	polls := 0
+	return func(ctx context.Context) (ResourceState, error) {
+		state := states[min(polls, len(states)-1)]
+		polls++
+		return state, nil
+	}, &polls
+}
+
+func TestWait(t *testing.T) {
+	up := ResourceState{Status: "UP"}
+	tests := []struct {
+		name   string
+		states []ResourceState
+		opts   WaitOptions
+		want   ResourceState
+		polls  int
+		err    error
+	}{
+		{
+			name:   "target",
+			states: []ResourceState{{Status: "CREATING"}, {Status: "CREATING"}, up},
+			opts:   WaitOptions{Target: []string{"up"}},
+			want:   up,
+			polls:  3,
+		},
+		{
+			name:   "from",
+			states: []ResourceState{up, {Status: "REBOOTING"}, up},
+			opts:   WaitOptions{Target: []string{"UP"}, From: []string{"UP"}},
+			want:   up,
+			polls:  3,
+		},
+		{
+			name:   "from finished between polls",
+			states: []ResourceState{up},
+			opts:   WaitOptions{Target: []string{"UP"}, From: []string{"UP"}},
+			want:   up,
+			polls:  DefaultFromPolls,
+		},
+		{
+			name:   "from left late",
+			states: []ResourceState{up, up, {Status: "REBOOTING"}, up},
+			opts:   WaitOptions{Target: []string{"UP"}, From: []string{"UP"}, FromPolls: 5},
+			want:   up,
+			polls:  4,
+		},
+		{
+			name:   "until gone",
+			states: []ResourceState{{Status: "DELETING"}, {Gone: true}},
+			opts:   WaitOptions{UntilGone: true},
+			want:   ResourceState{Gone: true},
+			polls:  2,
+		},
+		{
+			name:   "failure status",
+			states: []ResourceState{{Status: "CREATING"}, {Status: "Error"}},
+			opts:   WaitOptions{Target: []string{"UP"}},
+			want:   ResourceState{Status: "Error"},
+			polls:  2,
+			err:    &WaitFailedError{Status: "Error"},
+		},
+		{
+			name:   "failed flag",
+			states: []ResourceState{{Status: "Creating", Failed: true, Reason: "quota exceeded"}},
+			opts:   WaitOptions{Target: []string{"Active"}},
+			want:   ResourceState{Status: "Creating", Failed: true, Reason: "quota exceeded"},
+			polls:  1,
+			err:    &WaitFailedError{Status: "Creating", Reason: "quota exceeded"},
+		},
+		{
+			name:   "gone is not the target",
+			states: []ResourceState{{Gone: true}, {Status: "Running"}},
+			opts:   WaitOptions{Target: []string{"Running"}},
+			want:   ResourceState{Status: "Running"},
+			polls:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, polls := scripted(tt.states...)
+			tt.opts.Interval = time.Millisecond
+			got, err := Wait(context.Background(), poll, tt.opts)
+			if tt.err != nil {
+				var failed *WaitFailedError
+				if !errors.As(err, &failed) || *failed != *tt.err.(*WaitFailedError) {
+					t.Errorf("got error %v, want %v", err, tt.err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got state %+v, want %+v", got, tt.want)
+			}
+			if *polls != tt.polls {
+				t.Errorf("polled %d times, want %d", *polls, tt.polls)
+			}
+		})
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	poll, _ := scripted(ResourceState{Status: "CREATING"})
+	got, err := Wait(context.Background(), poll, WaitOptions{Target: []string{"UP"}, Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("got error %v, want ErrWaitTimeout", err)
+	}
+	if got.Status != "CREATING" {
+		t.Errorf("got state %+v, want the last one observed", got)
+	}
+}
+
+func TestWaitProgress(t *testing.T) {
+	poll, _ := scripted(ResourceState{Status: "A"}, ResourceState{Status: "A"}, ResourceState{Status: "B"}, ResourceState{Status: "C"})
+	var seen []string
+	_, err := Wait(context.Background(), poll, WaitOptions{
+		Target:   []string{"C"},
+		Interval: time.Millisecond,
+		Progress: func(state ResourceState, elapsed time.Duration) { seen = append(seen, state.Status) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 3 || seen[0] != "A" || seen[1] != "B" || seen[2] != "C" {
+		t.Errorf("got progress %v, want each change once: [A B C]", seen)
+	}
+}
+
+func TestWaitFakeRebootWithoutDelay(t *testing.T) {
+	srv := httptest.NewServer(fake.NewServer())
+	defer srv.Close()
+	client := NewClient("token", WithBaseURL(srv.URL))
+	ctx := context.Background()
+	zone := fake.DefaultZoneID
+
+	if _, err := client.CreateL2Network(ctx, zone, fakeL2Offering, "net"); err != nil {
+		t.Fatal(err)
+	}
+	networks, err := client.ListNetworks(ctx, zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateInstance(ctx, zone, fakeServiceOffering, fakeVMImage, []string{networks.Data[0].ID}, "web"); err != nil {
+		t.Fatal(err)
+	}
+	instances, err := client.ListInstances(ctx, zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The reboot is over before the first poll, so the instance never leaves UP
+	if _, err := client.RebootInstance(ctx, zone, instances.Data[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	opts := WaitOptions{
+		Target:   []string{InstanceStatusUp},
+		From:     []string{InstanceStatusUp},
+		Interval: 5 * time.Millisecond,
+		Timeout:  time.Second,
+	}
+	if state, err := Wait(ctx, client.PollInstance(zone, instances.Data[0].ID), opts); err != nil || state.Status != InstanceStatusUp {
+		t.Errorf("got %+v, %v, want the instance UP", state, err)
+	}
+}
+
+// Offerings and images of the fake server's seed data.
+const (
+	fakeServiceOffering = "01J9Y6ZQ5HS0FFER000000SM01"
+	fakeVMImage         = "01J9Y6ZQ5HVM1MAGE000002204"
+	fakeL2Offering      = "01J9Y6ZQ5HNETW0RK000000002"
+)
+
+func TestWaitFakeInstance(t *testing.T) {
+	srv := httptest.NewServer(fake.NewServer(fake.WithProvisionDelay(30 * time.Millisecond)))
+	defer srv.Close()
+	client := NewClient("token", WithBaseURL(srv.URL))
+	ctx := context.Background()
+	zone := fake.DefaultZoneID
+	opts := WaitOptions{Interval: 5 * time.Millisecond, Timeout: 5 * time.Second}
+
+	if _, err := client.CreateL2Network(ctx, zone, fakeL2Offering, "net"); err != nil {
+		t.Fatal(err)
+	}
+	networks, err := client.ListNetworks(ctx, zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poll, err := client.PollNewInstance(ctx, zone, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateInstance(ctx, zone, fakeServiceOffering, fakeVMImage, []string{networks.Data[0].ID}, "web"); err != nil {
+		t.Fatal(err)
+	}
+	opts.Target = []string{InstanceStatusUp}
+	if state, err := Wait(ctx, poll, opts); err != nil || state.Status != InstanceStatusUp {
+		t.Fatalf("got %+v, %v, want the instance UP", state, err)
+	}
+	instances, err := client.ListInstances(ctx, zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instanceID := instances.Data[0].ID
+
+	// A second snapshot with the same name must not be taken for the first
+	for range 2 {
+		poll, err := client.PollNewInstanceSnapshot(ctx, zone, instanceID, "daily")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.CreateInstanceSnapshot(ctx, zone, instanceID, "daily"); err != nil {
+			t.Fatal(err)
+		}
+		first, err := poll(ctx)
+		if err != nil || first.Status == SnapshotStatusReady {
+			t.Fatalf("got %+v, %v right after the create, want the new snapshot still pending", first, err)
+		}
+		opts.Target = []string{SnapshotStatusReady}
+		if state, err := Wait(ctx, poll, opts); err != nil || state.Status != SnapshotStatusReady {
+			t.Fatalf("got %+v, %v, want the snapshot READY", state, err)
+		}
+	}
+
+	if _, err := client.RebootInstance(ctx, zone, instanceID); err != nil {
+		t.Fatal(err)
+	}
+	var statuses []string
+	opts.Target, opts.From = []string{InstanceStatusUp}, []string{InstanceStatusUp}
+	opts.Progress = func(state ResourceState, elapsed time.Duration) { statuses = append(statuses, state.Status) }
+	if _, err := Wait(ctx, client.PollInstance(zone, instanceID), opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) < 2 || statuses[len(statuses)-1] != InstanceStatusUp {
+		t.Errorf("got statuses %v, want the instance to leave UP and come back", statuses)
+	}
+
+	if _, err := client.DeleteInstance(ctx, zone, instanceID, "web"); err != nil {
+		t.Fatal(err)
+	}
+	opts.From, opts.Progress, opts.UntilGone = nil, nil, true
+	if state, err := Wait(ctx, client.PollInstance(zone, instanceID), opts); err != nil || !state.Gone {
+		t.Errorf("got %+v, %v, want the instance gone", state, err)
+	}
+}
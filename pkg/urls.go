@@ -54,6 +54,10 @@ const (
 	UserProfile          string = "%s/user/profile"
 	UserTokenAbilities   string = "%s/user/token-abilities"
 	UserTokenValidate    string = "%s/user/token"
+	// UserTokenRevoke is not in the published API reference, so logout only
+	// calls it with --revoke. Callers must expect 404 and 405 from APIs
+	// without it, see http.IsUnsupported.
+	UserTokenRevoke string = "%s/user/token"
 
 	NetworkCreateL3           string = "%s/zone/%s/network/l3"
 	NetworkCreateL2           string = "%s/zone/%s/network/l2"